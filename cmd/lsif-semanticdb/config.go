@@ -0,0 +1,184 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// defaultConfigFile is read from the working directory if no --config flag
+// is given.
+const defaultConfigFile = "lsif-semanticdb.yaml"
+
+// config contains the values of a configuration file. They are the defaults
+// of the corresponding flags, so flags given on the command line take
+// precedence over the file. Relative paths are relative to the directory of
+// the file.
+type config struct {
+	// Input
+	SemanticdbDirs []string `yaml:"semanticdbDirs"`
+	ExcludeDirs    []string `yaml:"excludeDirs"`
+	SourceRoot     string   `yaml:"sourceRoot"`
+	Language       string   `yaml:"language"`
+
+	// Filters
+	Include        []string `yaml:"include"`
+	Exclude        []string `yaml:"exclude"`
+	IncludeSymbols []string `yaml:"includeSymbols"`
+	ExcludeSymbols []string `yaml:"excludeSymbols"`
+
+	// Output
+	Out              string `yaml:"out"`
+	OutDir           string `yaml:"outDir"`
+	UnresolvedReport string `yaml:"unresolvedReport"`
+	Progress         string `yaml:"progress"`
+	LogFormat        string `yaml:"logFormat"`
+	LogFile          string `yaml:"logFile"`
+	PositionEncoding string `yaml:"positionEncoding"`
+
+	// Monikers
+	PackageName      string `yaml:"packageName"`
+	PackageVersion   string `yaml:"packageVersion"`
+	ProjectPerModule bool   `yaml:"projectPerModule"`
+	ModuleMap        string `yaml:"moduleMap"`
+
+	// Features
+	NoContents bool `yaml:"noContents"`
+	Watch      bool `yaml:"watch"`
+}
+
+// loadConfig reads the configuration file named by the --config flag among
+// args, or the default configuration file if it exists. Without either, the
+// zero config is returned.
+func loadConfig(args []string) (*config, error) {
+	path, ok := configFlag(args)
+	if !ok {
+		if _, err := os.Stat(defaultConfigFile); err != nil {
+			return &config{}, nil
+		}
+		path = defaultConfigFile
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %v", err)
+	}
+
+	cfg := &config{}
+	if err := yaml.UnmarshalStrict(contents, cfg); err != nil {
+		return nil, fmt.Errorf("parse config %s: %v", path, err)
+	}
+
+	cfg.resolvePaths(filepath.Dir(path))
+	return cfg, nil
+}
+
+// configFlag returns the value of the --config flag. The flag is also
+// declared on the application, but it has to be known before the other
+// flags are declared as it provides their defaults.
+func configFlag(args []string) (string, bool) {
+	for i, arg := range args {
+		switch {
+		case arg == "--":
+			return "", false
+		case arg == "--config" && i+1 < len(args):
+			return args[i+1], true
+		case strings.HasPrefix(arg, "--config="):
+			return strings.TrimPrefix(arg, "--config="), true
+		}
+	}
+
+	return "", false
+}
+
+// resolvePaths makes the relative paths of the config relative to dir.
+func (c *config) resolvePaths(dir string) {
+	resolve := func(path string) string {
+		if path == "" || filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(dir, path)
+	}
+
+	for i := range c.SemanticdbDirs {
+		c.SemanticdbDirs[i] = resolve(c.SemanticdbDirs[i])
+	}
+	for i := range c.ExcludeDirs {
+		c.ExcludeDirs[i] = resolve(c.ExcludeDirs[i])
+	}
+	c.SourceRoot = resolve(c.SourceRoot)
+	c.Out = resolve(c.Out)
+	c.OutDir = resolve(c.OutDir)
+	c.UnresolvedReport = resolve(c.UnresolvedReport)
+	c.LogFile = resolve(c.LogFile)
+	c.ModuleMap = resolve(c.ModuleMap)
+}
+
+// orDefault returns value unless it is empty.
+func orDefault(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+func boolDefault(value bool) string {
+	return strconv.FormatBool(value)
+}
+
+// expandDirs expands the glob patterns among dirs and drops the directories
+// matching one of the exclude patterns. A path without glob characters is
+// kept even if it does not exist so that loading reports it.
+func expandDirs(dirs, exclude []string) ([]string, error) {
+	var expanded []string
+	for _, pattern := range dirs {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+		if len(matches) == 0 && !strings.ContainsAny(pattern, `*?[\`) {
+			matches = []string{pattern}
+		}
+
+	outer:
+		for _, dir := range matches {
+			for _, excluded := range exclude {
+				if ok, err := filepath.Match(excluded, dir); err != nil {
+					return nil, fmt.Errorf("invalid pattern %q: %v", excluded, err)
+				} else if ok {
+					continue outer
+				}
+			}
+
+			expanded = append(expanded, dir)
+		}
+	}
+
+	return expanded, nil
+}
+
+// resolveDirs expands the SemanticDB directories given by flags or the config
+// file with expandDirs and makes them absolute. It returns an error if no
+// directory remains.
+func resolveDirs(dirs, exclude []string) ([]string, error) {
+	expanded, err := expandDirs(dirs, exclude)
+	if err != nil {
+		return nil, err
+	}
+	if len(expanded) == 0 {
+		return nil, fmt.Errorf("no SemanticDB directories given, use --semanticdbDir or %s", defaultConfigFile)
+	}
+
+	for i, dir := range expanded {
+		if expanded[i], err = filepath.Abs(dir); err != nil {
+			return nil, fmt.Errorf("get abspath of SemanticDB dir: %v", err)
+		}
+	}
+
+	return expanded, nil
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.yaml")
+	contents := `semanticdbDirs: [target/meta, /abs/meta]
+sourceRoot: src
+language: java
+exclude: ["**/test/**"]
+noContents: true
+`
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{
+		{"index", "--config", path},
+		{"--config=" + path, "index"},
+	} {
+		cfg, err := loadConfig(args)
+		if err != nil {
+			t.Fatalf("loadConfig(%v): %v", args, err)
+		}
+
+		want := &config{
+			SemanticdbDirs: []string{filepath.Join(dir, "target", "meta"), "/abs/meta"},
+			SourceRoot:     filepath.Join(dir, "src"),
+			Language:       "java",
+			Exclude:        []string{"**/test/**"},
+			NoContents:     true,
+		}
+		if !reflect.DeepEqual(cfg, want) {
+			t.Errorf("loadConfig(%v) = %+v, want %+v", args, cfg, want)
+		}
+	}
+
+	// Flags after -- are arguments
+	if cfg, err := loadConfig([]string{"index", "--", "--config", path}); err != nil || !reflect.DeepEqual(cfg, &config{}) {
+		t.Errorf("loadConfig() = %+v, %v, want the zero config", cfg, err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("semanticdbDir: target\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadConfig([]string{"--config", path}); err == nil {
+		t.Errorf("expected an error for an unknown key")
+	}
+
+	if _, err := loadConfig([]string{"--config", filepath.Join(dir, "missing.yaml")}); err == nil {
+		t.Errorf("expected an error for a missing config file")
+	}
+}
+
+func TestLoadDefaultConfig(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	cfg, err := loadConfig(nil)
+	if err != nil || !reflect.DeepEqual(cfg, &config{}) {
+		t.Errorf("loadConfig() without a config file = %+v, %v, want the zero config", cfg, err)
+	}
+
+	if err := ioutil.WriteFile(defaultConfigFile, []byte("out: dumps/dump.lsif\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = loadConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join("dumps", "dump.lsif"); cfg.Out != want {
+		t.Errorf("out %q, want %q", cfg.Out, want)
+	}
+}
+
+func TestResolvePaths(t *testing.T) {
+	cfg := &config{
+		SemanticdbDirs:   []string{"a", "/b"},
+		ExcludeDirs:      []string{"a/*/test"},
+		SourceRoot:       ".",
+		Out:              "dump.lsif",
+		OutDir:           "/out",
+		UnresolvedReport: "unresolved.json",
+		LogFile:          "log.txt",
+		ModuleMap:        "modules.json",
+		Include:          []string{"src/**"},
+	}
+	cfg.resolvePaths("/config")
+
+	want := &config{
+		SemanticdbDirs:   []string{"/config/a", "/b"},
+		ExcludeDirs:      []string{"/config/a/*/test"},
+		SourceRoot:       "/config",
+		Out:              "/config/dump.lsif",
+		OutDir:           "/out",
+		UnresolvedReport: "/config/unresolved.json",
+		LogFile:          "/config/log.txt",
+		ModuleMap:        "/config/modules.json",
+		// Document patterns match URIs rather than paths
+		Include: []string{"src/**"},
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("resolvePaths() = %+v, want %+v", cfg, want)
+	}
+}
+
+func TestExpandDirs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"core", "app", "app-test"} {
+		if err := os.MkdirAll(filepath.Join(dir, name, "target", "meta"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	join := func(elem ...string) string {
+		return filepath.Join(append([]string{dir}, elem...)...)
+	}
+
+	dirs, err := expandDirs(
+		[]string{join("*", "target", "meta"), join("missing"), join("none*")},
+		[]string{join("*-test", "*", "*")},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Paths without glob characters are kept even if they do not exist
+	want := []string{join("app", "target", "meta"), join("core", "target", "meta"), join("missing")}
+	if !reflect.DeepEqual(dirs, want) {
+		t.Errorf("expandDirs() = %v, want %v", dirs, want)
+	}
+
+	if _, err := expandDirs([]string{join("[")}, nil); err == nil {
+		t.Errorf("expected an error for an invalid pattern")
+	}
+	if _, err := expandDirs([]string{join("core")}, []string{"["}); err == nil {
+		t.Errorf("expected an error for an invalid exclude pattern")
+	}
+}
+
+func TestResolveDirs(t *testing.T) {
+	if _, err := resolveDirs(nil, nil); err == nil {
+		t.Errorf("expected an error without directories")
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dirs := []string{"a"}
+	resolved, err := resolveDirs(dirs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{filepath.Join(wd, "a")}; !reflect.DeepEqual(resolved, want) {
+		t.Errorf("resolveDirs() = %v, want %v", resolved, want)
+	}
+	if dirs[0] != "a" {
+		t.Errorf("resolveDirs() modified its argument: %v", dirs)
+	}
+}
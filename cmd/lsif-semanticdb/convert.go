@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+var semanticdbFormats = []string{"binary", "textproto", "json"}
+
+type convertOptions struct {
+	inFile  string
+	outFile string
+	from    string
+	to      string
+}
+
+func newConvertCommand(app *kingpin.Application) *command {
+	opts := &convertOptions{}
+
+	clause := app.Command("convert", "Convert a SemanticDB file between the binary, textproto and JSON formats.")
+	clause.Flag("from", "The format of the input. By default it is inferred from the extension of the input file.").EnumVar(&opts.from, semanticdbFormats...)
+	clause.Flag("to", "The format of the output. By default it is inferred from the extension of the output file, or textproto when writing to stdout.").EnumVar(&opts.to, semanticdbFormats...)
+	clause.Arg("input", "The SemanticDB file to convert. It is read from stdin by default.").StringVar(&opts.inFile)
+	clause.Arg("output", "The file the converted documents are written to. They are written to stdout by default.").StringVar(&opts.outFile)
+
+	return &command{
+		clause: clause,
+		run:    func() error { return runConvert(opts) },
+	}
+}
+
+func runConvert(opts *convertOptions) error {
+	from, err := convertFormat(opts.from, opts.inFile, semanticdb.Binary)
+	if err != nil {
+		return err
+	}
+	to, err := convertFormat(opts.to, opts.outFile, semanticdb.Textproto)
+	if err != nil {
+		return err
+	}
+
+	var contents []byte
+	if opts.inFile == "" {
+		contents, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		contents, err = ioutil.ReadFile(opts.inFile)
+	}
+	if err != nil {
+		return fmt.Errorf("read input: %v", err)
+	}
+
+	textDocuments, err := semanticdb.Unmarshal(contents, from)
+	if err != nil {
+		return fmt.Errorf("decode input: %v", err)
+	}
+
+	contents, err = semanticdb.Marshal(textDocuments, to)
+	if err != nil {
+		return fmt.Errorf("encode: %v", err)
+	}
+	if to != semanticdb.Binary {
+		contents = append(contents, '\n')
+	}
+
+	if opts.outFile == "" {
+		_, err = os.Stdout.Write(contents)
+	} else {
+		err = ioutil.WriteFile(opts.outFile, contents, 0644)
+	}
+	if err != nil {
+		return fmt.Errorf("write output: %v", err)
+	}
+
+	return nil
+}
+
+// convertFormat returns the format given by a flag, or else the format of the
+// file according to its extension, or else the default format.
+func convertFormat(flag, path string, def semanticdb.Format) (semanticdb.Format, error) {
+	if flag != "" {
+		return semanticdb.ParseFormat(flag)
+	}
+
+	if format, ok := semanticdb.FormatOf(path); ok {
+		return format, nil
+	}
+
+	return def, nil
+}
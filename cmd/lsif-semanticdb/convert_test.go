@@ -0,0 +1,92 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+	"google.golang.org/protobuf/proto"
+)
+
+const convertDocuments = `documents {
+  schema: SEMANTICDB4
+  uri: "src/A.scala"
+  text: "object A"
+  language: SCALA
+  occurrences { range { start_character: 7 end_character: 8 } symbol: "a/A." role: DEFINITION }
+}
+`
+
+func TestConvertFormat(t *testing.T) {
+	testCases := []struct {
+		flag string
+		path string
+		want semanticdb.Format
+	}{
+		{"", "A.semanticdb", semanticdb.Binary},
+		{"", "A.semanticdb.json", semanticdb.JSON},
+		{"", "A.txt", semanticdb.Textproto},
+		{"", "", semanticdb.Textproto},
+		{"json", "A.semanticdb", semanticdb.JSON},
+	}
+
+	for _, testCase := range testCases {
+		if got, err := convertFormat(testCase.flag, testCase.path, semanticdb.Textproto); err != nil || got != testCase.want {
+			t.Errorf("convertFormat(%q, %q) = %v, %v, want %v", testCase.flag, testCase.path, got, err, testCase.want)
+		}
+	}
+
+	if _, err := convertFormat("xml", "", semanticdb.Binary); err == nil {
+		t.Errorf("expected an error for an unknown format")
+	}
+}
+
+func TestRunConvert(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	textproto := filepath.Join(dir, "A.scala.semanticdb.textproto")
+	binary := filepath.Join(dir, "A.scala.semanticdb")
+	json := filepath.Join(dir, "A.json")
+	roundTrip := filepath.Join(dir, "B.scala.semanticdb.textproto")
+	if err := ioutil.WriteFile(textproto, []byte(convertDocuments), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The formats are inferred from the extensions unless given
+	for _, opts := range []*convertOptions{
+		{inFile: textproto, outFile: binary},
+		{inFile: binary, outFile: json, to: "json"},
+		{inFile: json, outFile: roundTrip, from: "json"},
+	} {
+		if err := runConvert(opts); err != nil {
+			t.Fatalf("runConvert(%+v): %v", opts, err)
+		}
+	}
+
+	want, err := semanticdb.ReadFile(textproto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{binary, roundTrip} {
+		got, err := semanticdb.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(got, want) {
+			t.Errorf("%s: got %v, want %v", filepath.Base(path), got, want)
+		}
+	}
+
+	if err := runConvert(&convertOptions{inFile: filepath.Join(dir, "missing.semanticdb"), outFile: binary}); err == nil {
+		t.Errorf("expected an error for a missing input")
+	}
+	if err := runConvert(&convertOptions{inFile: json, outFile: binary}); err == nil {
+		t.Errorf("expected an error decoding JSON as binary")
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/sourcegraph/lsif-semanticdb/internal/lsif"
+)
+
+// errDumpsDiffer is returned by the diff command with --exitCode if the dumps
+// differ.
+var errDumpsDiffer = errors.New("dumps differ")
+
+type diffOptions struct {
+	oldFile  string
+	newFile  string
+	format   string
+	exitCode bool
+}
+
+func newDiffCommand(app *kingpin.Application) *command {
+	opts := &diffOptions{}
+
+	clause := app.Command("diff", "Compare the definitions, references, hovers and monikers of two LSIF dumps.")
+	clause.Flag("format", "The output format.").Default("text").EnumVar(&opts.format, "text", "json")
+	clause.Flag("exitCode", "Fail if the dumps differ.").Default("false").BoolVar(&opts.exitCode)
+	clause.Arg("old", "The LSIF dump to compare against.").Required().StringVar(&opts.oldFile)
+	clause.Arg("new", "The LSIF dump to compare.").Required().StringVar(&opts.newFile)
+
+	return &command{
+		clause: clause,
+		run:    func() error { return runDiff(opts) },
+	}
+}
+
+func runDiff(opts *diffOptions) error {
+	oldDocuments, err := readDocuments(opts.oldFile)
+	if err != nil {
+		return err
+	}
+	newDocuments, err := readDocuments(opts.newFile)
+	if err != nil {
+		return err
+	}
+
+	diffs := lsif.Diff(oldDocuments, newDocuments)
+
+	if opts.format == "json" {
+		if diffs == nil {
+			diffs = []*lsif.DocumentDiff{}
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(diffs); err != nil {
+			return fmt.Errorf("write diff: %v", err)
+		}
+	} else {
+		w := bufio.NewWriter(os.Stdout)
+		printDiff(w, opts, diffs)
+		if err := w.Flush(); err != nil {
+			return fmt.Errorf("write diff: %v", err)
+		}
+	}
+
+	if opts.exitCode && len(diffs) > 0 {
+		return errDumpsDiffer
+	}
+	return nil
+}
+
+// readDocuments reads the navigation data of the documents of a dump.
+func readDocuments(path string) ([]*lsif.Document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open dump file: %v", err)
+	}
+	defer f.Close()
+
+	elements, err := lsif.Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("read dump file %s: %v", path, err)
+	}
+
+	documents, err := lsif.Documents(elements)
+	if err != nil {
+		return nil, fmt.Errorf("read dump file %s: %v", path, err)
+	}
+
+	return documents, nil
+}
+
+// printDiff writes the diff with a line per added or removed result, followed
+// by a summary.
+func printDiff(w io.Writer, opts *diffOptions, diffs []*lsif.DocumentDiff) {
+	if len(diffs) == 0 {
+		fmt.Fprintln(w, "No differences")
+		return
+	}
+
+	fmt.Fprintf(w, "--- %s\n+++ %s\n", opts.oldFile, opts.newFile)
+
+	added := map[string]int{}
+	removed := map[string]int{}
+	numDocuments := map[string]int{}
+	for _, document := range diffs {
+		numDocuments[document.Status]++
+		fmt.Fprintf(w, "document %s (%s)\n", document.URI, document.Status)
+
+		for _, r := range document.Ranges {
+			fmt.Fprintf(w, "  range %s (%s)\n", r.Range, r.Status)
+			for _, result := range r.Results {
+				for _, value := range result.Removed {
+					fmt.Fprintf(w, "    - %s %s\n", result.Kind, value)
+				}
+				for _, value := range result.Added {
+					fmt.Fprintf(w, "    + %s %s\n", result.Kind, value)
+				}
+				added[result.Kind] += len(result.Added)
+				removed[result.Kind] += len(result.Removed)
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "\n%d document(s) changed, %d added, %d removed\n", numDocuments[lsif.Changed], numDocuments[lsif.Added], numDocuments[lsif.Removed])
+	for _, kind := range lsif.ResultKinds {
+		if added[kind] > 0 || removed[kind] > 0 {
+			fmt.Fprintf(w, "%s results: +%d -%d\n", kind, added[kind], removed[kind])
+		}
+	}
+}
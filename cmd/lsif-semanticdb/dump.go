@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin"
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+type dumpOptions struct {
+	paths      []string
+	sourceRoot string
+}
+
+func newDumpCommand(app *kingpin.Application) *command {
+	opts := &dumpOptions{}
+
+	clause := app.Command("dump", "Print the documents of SemanticDB files like metap, with occurrences annotated in the source.")
+	clause.Flag("sourceRoot", "The directory document URIs are relative to, used to read the source of documents without text.").Default(".").StringVar(&opts.sourceRoot)
+	clause.Arg("path", "SemanticDB files or directories containing them.").Required().StringsVar(&opts.paths)
+
+	return &command{
+		clause: clause,
+		run:    func() error { return runDump(opts) },
+	}
+}
+
+func runDump(opts *dumpOptions) error {
+	w := bufio.NewWriter(os.Stdout)
+	err := printPaths(w, opts)
+
+	// Documents printed before an error are still written
+	if flushErr := w.Flush(); flushErr != nil && err == nil {
+		err = fmt.Errorf("write dump: %v", flushErr)
+	}
+	return err
+}
+
+// printPaths prints the documents of the SemanticDB files at the given paths.
+func printPaths(w io.Writer, opts *dumpOptions) error {
+	first := true
+	printDocuments := func(path string, textDocuments *pb.TextDocuments) error {
+		for _, document := range textDocuments.GetDocuments() {
+			if !first {
+				fmt.Fprintln(w)
+			}
+			first = false
+
+			if err := semanticdb.PrintDocument(w, document, opts.source(document)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, path := range opts.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			err = semanticdb.Walk([]string{path}, printDocuments)
+		} else {
+			var textDocuments *pb.TextDocuments
+			textDocuments, err = semanticdb.ReadFile(path)
+			if err == nil {
+				err = printDocuments(path, textDocuments)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("dump %s: %v", path, err)
+		}
+	}
+
+	return nil
+}
+
+// source returns the source text of a document that does not contain it, or
+// the empty string if the source file cannot be read.
+func (opts *dumpOptions) source(document *pb.TextDocument) string {
+	if document.GetText() != "" {
+		return ""
+	}
+
+	contents, err := ioutil.ReadFile(filepath.Join(opts.sourceRoot, document.GetUri()))
+	if err != nil {
+		return ""
+	}
+	return string(contents)
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/sourcegraph/lsif-semanticdb/internal/index"
+	"github.com/sourcegraph/lsif-semanticdb/internal/log"
+	"github.com/sourcegraph/lsif-semanticdb/internal/watch"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+type indexOptions struct {
+	*globalOptions
+	semanticdbDirs   []string
+	excludeDirs      []string
+	sourceRoot       string
+	language         string
+	include          []string
+	exclude          []string
+	includeSymbols   []string
+	excludeSymbols   []string
+	noContents       bool
+	outFile          string
+	unresolvedReport string
+	watch            bool
+	projectPerModule bool
+	moduleMap        string
+	outDir           string
+	packageName      string
+	packageVersion   string
+	progress         string
+	positionEncoding string
+}
+
+// newIndexCommand creates the index command. It is the default command so
+// that flags given without a command name are passed to it. The values of the
+// config file are the defaults of the flags.
+func newIndexCommand(app *kingpin.Application, global *globalOptions, cfg *config) *command {
+	opts := &indexOptions{globalOptions: global}
+
+	clause := app.Command("index", "Convert SemanticDB files into an LSIF dump.").Default()
+	clause.Flag("semanticdbDir", "Specifies the directory of the META-INF/semanticdb directory. Glob patterns are expanded.").Default(cfg.SemanticdbDirs...).StringsVar(&opts.semanticdbDirs)
+	clause.Flag("excludeDir", "Skip the SemanticDB directories matching this glob pattern.").Default(cfg.ExcludeDirs...).StringsVar(&opts.excludeDirs)
+	clause.Flag("sourceRoot", "The directory document URIs are relative to.").Default(orDefault(cfg.SourceRoot, ".")).StringVar(&opts.sourceRoot)
+	clause.Flag("language", "The language of the project: scala or java.").Default(orDefault(cfg.Language, index.LanguageScala)).EnumVar(&opts.language, index.LanguageScala, index.LanguageJava)
+	clause.Flag("include", "Only index documents whose URI matches this glob pattern.").Default(cfg.Include...).StringsVar(&opts.include)
+	clause.Flag("exclude", "Skip documents whose URI matches this glob pattern, e.g. '**/test/**'.").Default(cfg.Exclude...).StringsVar(&opts.exclude)
+	clause.Flag("includeSymbol", "Only index occurrences of global symbols with this prefix.").Default(cfg.IncludeSymbols...).StringsVar(&opts.includeSymbols)
+	clause.Flag("excludeSymbol", "Skip occurrences of global symbols with this prefix, e.g. 'scala/'.").Default(cfg.ExcludeSymbols...).StringsVar(&opts.excludeSymbols)
+	clause.Flag("noContents", "File contents will not be embedded into the dump.").Default(boolDefault(cfg.NoContents)).BoolVar(&opts.noContents)
+	clause.Flag("out", "The output file the dump is saved to.").Default(orDefault(cfg.Out, "dump.lsif")).StringVar(&opts.outFile)
+	clause.Flag("watch", "Regenerate the dump whenever SemanticDB files change.").Default(boolDefault(cfg.Watch)).BoolVar(&opts.watch)
+	clause.Flag("unresolvedReport", "Write a JSON report of references without a definition to this file.").Default(cfg.UnresolvedReport).StringVar(&opts.unresolvedReport)
+	clause.Flag("projectPerModule", "Emit one dump per sbt or Gradle module, linked with monikers.").Default(boolDefault(cfg.ProjectPerModule)).BoolVar(&opts.projectPerModule)
+	clause.Flag("moduleMap", "A JSON file mapping module names to their SemanticDB directories. Implies --projectPerModule.").Default(cfg.ModuleMap).StringVar(&opts.moduleMap)
+	clause.Flag("outDir", "The directory the dumps of each module are saved to.").Default(orDefault(cfg.OutDir, ".")).StringVar(&opts.outDir)
+	clause.Flag("positionEncoding", "The encoding of the character offsets of ranges: utf16, utf8 or codepoint. The metaData vertex declares it as utf-16, utf-8 or utf-32.").Default(orDefault(cfg.PositionEncoding, index.PositionEncodingUTF16)).EnumVar(&opts.positionEncoding, index.PositionEncodings...)
+	clause.Flag("progress", "How to display progress on stderr: auto, bar, dots, json or none.").Default(orDefault(cfg.Progress, "auto")).EnumVar(&opts.progress, progressModes...)
+	clause.Flag("packageName", "The package exporting the symbols of the dump with monikers.").Default(cfg.PackageName).StringVar(&opts.packageName)
+	clause.Flag("packageVersion", "The version of the packages referenced by monikers. Defaults to the git commit of the source root.").Default(cfg.PackageVersion).StringVar(&opts.packageVersion)
+
+	return &command{
+		clause: clause,
+		run:    func() error { return runIndex(opts) },
+	}
+}
+
+func (opts *indexOptions) filter() index.Filter {
+	return index.Filter{
+		IncludeDocuments: opts.include,
+		ExcludeDocuments: opts.exclude,
+		IncludeSymbols:   opts.includeSymbols,
+		ExcludeSymbols:   opts.excludeSymbols,
+	}
+}
+
+func runIndex(opts *indexOptions) (err error) {
+	// Progress goes to stderr with the log messages. In auto mode it is not
+	// displayed when verbose messages are logged
+	progress, err := newProgressRenderer(opts.progress, opts.verbose || opts.debug, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	opts.semanticdbDirs, err = resolveDirs(opts.semanticdbDirs, opts.excludeDirs)
+	if err != nil {
+		return err
+	}
+
+	toolInfo := protocol.ToolInfo{
+		Name:    "lsif-semanticdb",
+		Version: version,
+		Args:    os.Args[1:],
+	}
+
+	perModule := opts.projectPerModule || opts.moduleMap != ""
+	if (perModule || opts.packageName != "") && opts.packageVersion == "" {
+		if opts.packageVersion, err = inferPackageVersion(opts.sourceRoot); err != nil {
+			return err
+		}
+	}
+
+	if perModule {
+		if opts.watch {
+			return fmt.Errorf("--watch cannot be combined with --projectPerModule")
+		}
+
+		return runIndexPerModule(opts, toolInfo, progress)
+	}
+
+	out, err := createOutputFile(opts.outFile)
+	if err != nil {
+		return fmt.Errorf("create dump file: %v", err)
+	}
+
+	// Remove the partial dump unless it was committed below
+	defer out.Abort()
+
+	var monikers *index.Monikers
+	if opts.packageName != "" {
+		monikers = &index.Monikers{
+			PackageName:    opts.packageName,
+			PackageVersion: opts.packageVersion,
+		}
+	}
+
+	indexer := index.NewIndexer(
+		opts.semanticdbDirs,
+		toolInfo,
+		out,
+		index.Options{
+			SourceRoot:       opts.sourceRoot,
+			Language:         opts.language,
+			PositionEncoding: opts.positionEncoding,
+			Contents:         !opts.noContents,
+			Filter:           opts.filter(),
+			Monikers:         monikers,
+			Logger:           opts.logger,
+			Progress:         progress,
+		},
+	)
+
+	start := time.Now()
+	s, err := indexer.Index()
+	if err := finishDump(out, s, err, start, progress, opts.logger); err != nil {
+		return err
+	}
+
+	if err := writeUnresolvedReport(opts.unresolvedReport, indexer.UnresolvedReferences()); err != nil {
+		return err
+	}
+
+	if opts.watch {
+		return watchIndex(opts, indexer, progress)
+	}
+
+	return nil
+}
+
+// watchIndex regenerates the dump whenever SemanticDB files change. Errors
+// while reindexing are reported without ending the watch.
+func watchIndex(opts *indexOptions, indexer index.Indexer, progress progressRenderer) error {
+	w, err := watch.New(opts.semanticdbDirs)
+	if err != nil {
+		return fmt.Errorf("watch: %v", err)
+	}
+	defer w.Close()
+
+	opts.logger.Info("Watching for changes")
+
+	for {
+		select {
+		case paths := <-w.Changes():
+			opts.logger.Info("SemanticDB files changed", log.F("count", len(paths)))
+			if err := reindex(opts, indexer, paths, progress); err != nil {
+				opts.logger.Error("Reindexing failed", log.F("error", err))
+			}
+
+		case err := <-w.Errors():
+			return fmt.Errorf("watch: %v", err)
+		}
+	}
+}
+
+func reindex(opts *indexOptions, indexer index.Indexer, paths []string, progress progressRenderer) error {
+	out, err := createOutputFile(opts.outFile)
+	if err != nil {
+		return fmt.Errorf("create dump file: %v", err)
+	}
+	defer out.Abort()
+
+	start := time.Now()
+	s, err := indexer.Reindex(out, paths)
+	if err := finishDump(out, s, err, start, progress, opts.logger); err != nil {
+		return err
+	}
+
+	return writeUnresolvedReport(opts.unresolvedReport, indexer.UnresolvedReferences())
+}
+
+// runIndexPerModule writes a dump for each module. References between modules
+// are linked with monikers naming the module as package.
+func runIndexPerModule(opts *indexOptions, toolInfo protocol.ToolInfo, progress progressRenderer) error {
+	var modules []index.Module
+	var err error
+	if opts.moduleMap != "" {
+		modules, err = index.ReadModuleMap(opts.moduleMap)
+	} else {
+		modules, err = index.InferModules(opts.semanticdbDirs)
+	}
+	if err != nil {
+		return fmt.Errorf("determine modules: %v", err)
+	}
+
+	packages, resolver, err := index.ModulePackages(modules, opts.filter())
+	if err != nil {
+		return fmt.Errorf("index: %v", err)
+	}
+
+	if err := os.MkdirAll(opts.outDir, 0755); err != nil {
+		return fmt.Errorf("create output directory: %v", err)
+	}
+
+	var unresolved []*index.UnresolvedReference
+	for _, module := range modules {
+		monikers := &index.Monikers{
+			PackageName:    module.Name,
+			PackageVersion: opts.packageVersion,
+			Packages:       packages,
+			Resolver:       resolver,
+		}
+
+		moduleUnresolved, err := indexModule(opts, toolInfo, module, monikers, progress)
+		if err != nil {
+			return fmt.Errorf("module %s: %v", module.Name, err)
+		}
+		unresolved = append(unresolved, moduleUnresolved...)
+	}
+
+	return writeUnresolvedReport(opts.unresolvedReport, unresolved)
+}
+
+// indexModule writes the dump of a module to the output directory and returns
+// its unresolved references.
+func indexModule(opts *indexOptions, toolInfo protocol.ToolInfo, module index.Module, monikers *index.Monikers, progress progressRenderer) ([]*index.UnresolvedReference, error) {
+	out, err := createOutputFile(filepath.Join(opts.outDir, strings.Replace(module.Name, "/", "-", -1)+".lsif"))
+	if err != nil {
+		return nil, fmt.Errorf("create dump file: %v", err)
+	}
+	defer out.Abort()
+
+	logger := opts.logger.With(log.F("module", module.Name))
+	logger.Info("Indexing module")
+
+	indexer := index.NewIndexer(
+		module.Dirs,
+		toolInfo,
+		out,
+		index.Options{
+			SourceRoot:       opts.sourceRoot,
+			Language:         opts.language,
+			PositionEncoding: opts.positionEncoding,
+			Contents:         !opts.noContents,
+			Filter:           opts.filter(),
+			Monikers:         monikers,
+			Logger:           logger,
+			Progress:         progress,
+		},
+	)
+
+	start := time.Now()
+	s, err := indexer.Index()
+	if err := finishDump(out, s, err, start, progress, logger, log.F("module", module.Name)); err != nil {
+		return nil, err
+	}
+
+	return indexer.UnresolvedReferences(), nil
+}
+
+// finishDump commits the dump written by the indexer and prints its
+// statistics after the given fields, or returns the error that occurred while
+// indexing.
+func finishDump(out *outputFile, s *index.Stats, err error, start time.Time, progress progressRenderer, logger log.Logger, summary ...log.Field) error {
+	// End progress output before logging the summary or error
+	progress.End()
+
+	if err != nil {
+		return fmt.Errorf("index: %v", err)
+	}
+
+	if err := out.Commit(); err != nil {
+		return fmt.Errorf("write dump file: %v", err)
+	}
+
+	// The summary is printed regardless of the log level, like the result
+	// of the command
+	fields := append(summary,
+		log.F("files", s.NumFiles),
+		log.F("defs", s.NumDefs),
+		log.F("elements", s.NumElements),
+		log.F("duration", time.Since(start).String()),
+	)
+	fmt.Fprintln(os.Stderr, log.FormatMessage("Processed SemanticDB files", fields))
+	if s.NumRejectedOccurrences > 0 {
+		logger.Warn("Rejected occurrences with invalid ranges", log.F("count", s.NumRejectedOccurrences))
+	}
+	return nil
+}
+
+// inferPackageVersion returns the commit checked out in the source root as
+// the version of the packages referenced by monikers.
+func inferPackageVersion(sourceRoot string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "HEAD")
+	cmd.Dir = sourceRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("infer package version from git, use --packageVersion instead: %v", err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// writeUnresolvedReport writes the unresolved references as JSON, unless no
+// report was requested.
+func writeUnresolvedReport(path string, unresolved []*index.UnresolvedReference) error {
+	if path == "" {
+		return nil
+	}
+
+	contents, err := json.MarshalIndent(unresolved, "", "  ")
+	if err == nil {
+		err = ioutil.WriteFile(path, append(contents, '\n'), 0644)
+	}
+	if err != nil {
+		return fmt.Errorf("write unresolved report: %v", err)
+	}
+
+	return nil
+}
@@ -4,16 +4,14 @@ package main
 import (
 	"fmt"
 	"os"
-	"path/filepath"
-	"time"
 
 	"github.com/alecthomas/kingpin"
-	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
-	"github.com/sourcegraph/lsif-semanticdb/internal/index"
 	"github.com/sourcegraph/lsif-semanticdb/internal/log"
+	"github.com/sourcegraph/lsif-semanticdb/pkg/lsifsemanticdb"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
 )
 
-const version = "0.4.1"
+const version = lsifsemanticdb.Version
 const versionString = version + ", protocol version " + protocol.Version
 
 func main() {
@@ -23,79 +21,101 @@ func main() {
 	}
 }
 
-func realMain() error {
-	var (
-		debug          bool
-		verbose        bool
-		semanticdbDirs []string
-		noContents     bool
-		outFile        string
-	)
+// globalOptions contains the flags shared by all commands.
+type globalOptions struct {
+	debug     bool
+	verbose   bool
+	logFormat string
+	logFile   string
 
-	app := kingpin.New("lsif-semanticdb", "lsif-semanticdb is an LSIF indexer for SemanticDB.").Version(versionString)
-	app.Flag("debug", "Display debug information.").Default("false").BoolVar(&debug)
-	app.Flag("verbose", "Display verbose information.").Short('v').Default("false").BoolVar(&verbose)
-	app.Flag("semanticdbDir", "Specifies the directory of the META-INF/semanticdb directory.").Required().StringsVar(&semanticdbDirs)
-	app.Flag("noContents", "File contents will not be embedded into the dump.").Default("false").BoolVar(&noContents)
-	app.Flag("out", "The output file the dump is saved to.").Default("dump.lsif").StringVar(&outFile)
+	// logger is created from the flags above once they are parsed
+	logger log.Logger
+}
+
+// command is a subcommand of the application.
+type command struct {
+	clause *kingpin.CmdClause
+	run    func() error
+}
+
+func realMain() error {
+	var opts globalOptions
 
-	_, err := app.Parse(os.Args[1:])
+	cfg, err := loadConfig(os.Args[1:])
 	if err != nil {
 		return err
 	}
 
-	if verbose {
-		log.SetLevel(log.Info)
+	app := kingpin.New("lsif-semanticdb", "lsif-semanticdb is an LSIF indexer for SemanticDB.").Version(versionString)
+	app.Flag("debug", "Display debug information.").Default("false").BoolVar(&opts.debug)
+	app.Flag("verbose", "Display verbose information.").Short('v').Default("false").BoolVar(&opts.verbose)
+	app.Flag("config", "Read flag defaults from this YAML file instead of "+defaultConfigFile+".").String()
+	app.Flag("logFormat", "The format of log messages: text or json.").Default(orDefault(cfg.LogFormat, "text")).EnumVar(&opts.logFormat, "text", "json")
+	app.Flag("logFile", "Write log messages to this file instead of stderr.").Default(cfg.LogFile).StringVar(&opts.logFile)
+
+	commands := []*command{
+		newIndexCommand(app, &opts, cfg),
+		newValidateCommand(app),
+		newStatsCommand(app, cfg),
+		newServeCommand(app, &opts, cfg),
+		newConvertCommand(app),
+		newDumpCommand(app),
+		newDiffCommand(app),
+		newQueryCommand(app, &opts, cfg),
 	}
 
-	if debug {
-		log.SetLevel(log.Debug)
+	selected, err := app.Parse(os.Args[1:])
+	if err != nil {
+		return err
 	}
 
-	// Print progress dots if we have no other output
-	printProgressDots := !verbose && !debug
-
-	out, err := os.Create(outFile)
+	logger, closeLog, err := newLogger(&opts)
 	if err != nil {
-		return fmt.Errorf("create dump file: %v", err)
+		return err
 	}
+	defer closeLog()
+	opts.logger = logger
 
-	defer out.Close()
-
-	for i, dir := range semanticdbDirs {
-		semanticdbDirs[i], err = filepath.Abs(dir)
-		if err != nil {
-			return fmt.Errorf("get abspath of SemanticDB dir: %v", err)
+	for _, c := range commands {
+		if c.clause.FullCommand() == selected {
+			return c.run()
 		}
 	}
 
-	toolInfo := protocol.ToolInfo{
-		Name:    "lsif-semanticdb",
-		Version: version,
-		Args:    os.Args[1:],
+	return fmt.Errorf("unknown command %q", selected)
+}
+
+// newLogger creates the logger configured by the global flags.
+func newLogger(opts *globalOptions) (log.Logger, func(), error) {
+	level := logLevel(opts)
+
+	format, err := log.ParseFormat(opts.logFormat)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	indexer := index.NewIndexer(
-		semanticdbDirs,
-		// noContents,
-		printProgressDots,
-		toolInfo,
-		out,
-	)
-
-	start := time.Now()
-	s, err := indexer.Index()
-	if printProgressDots {
-		// End progress line before printing summary or error
-		log.Println()
-		log.Println()
+	if opts.logFile == "" {
+		return log.New(os.Stderr, level, format), func() {}, nil
 	}
 
+	f, err := os.OpenFile(opts.logFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
 	if err != nil {
-		return fmt.Errorf("index: %v", err)
+		return nil, nil, fmt.Errorf("open log file: %v", err)
+	}
+
+	return log.New(f, level, format), func() { _ = f.Close() }, nil
+}
+
+// logLevel returns the level of the logger configured by the global flags.
+// Warnings and errors are always logged; --verbose adds the progress messages
+// and --debug the debug messages. The summary of a dump is always printed.
+func logLevel(opts *globalOptions) log.Level {
+	switch {
+	case opts.debug:
+		return log.Debug
+	case opts.verbose:
+		return log.Info
 	}
 
-	log.Printf("%d file(s), %d def(s), %d element(s)", s.NumFiles, s.NumDefs, s.NumElements)
-	log.Println("Processed in", time.Since(start))
-	return nil
+	return log.Warn
 }
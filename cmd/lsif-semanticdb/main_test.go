@@ -0,0 +1,23 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/log"
+)
+
+func TestLogLevel(t *testing.T) {
+	for _, test := range []struct {
+		opts globalOptions
+		want log.Level
+	}{
+		{globalOptions{}, log.Warn},
+		{globalOptions{verbose: true}, log.Info},
+		{globalOptions{debug: true}, log.Debug},
+		{globalOptions{verbose: true, debug: true}, log.Debug},
+	} {
+		if got := logLevel(&test.opts); got != test.want {
+			t.Errorf("logLevel(%+v) = %v, want %v", test.opts, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// outputFile is a dump file that is written to a temporary file in the same
+// directory as its destination and only renamed into place once committed.
+// This ensures that a failed or interrupted run never leaves a partially
+// written dump behind.
+type outputFile struct {
+	*os.File
+	path    string
+	signals chan os.Signal
+}
+
+// createOutputFile creates a temporary file next to the given path. The
+// temporary file is removed if the process receives SIGINT or SIGTERM before
+// the file is committed.
+func createOutputFile(path string) (*outputFile, error) {
+	f, err := createTempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return nil, err
+	}
+
+	o := &outputFile{
+		File:    f,
+		path:    path,
+		signals: make(chan os.Signal, 1),
+	}
+
+	signal.Notify(o.signals, syscall.SIGINT, syscall.SIGTERM)
+	go o.watchSignals(o.signals)
+
+	return o, nil
+}
+
+// createTempFile creates a file with a unique name starting with the prefix in
+// dir. Unlike ioutil.TempFile, which creates files readable only by the owner,
+// it uses the permissions of os.Create, 0666 before the umask, since the file
+// becomes the dump.
+func createTempFile(dir, prefix string) (*os.File, error) {
+	for try := 0; ; try++ {
+		suffix := strconv.Itoa(os.Getpid()) + "-" + strconv.FormatInt(time.Now().UnixNano(), 36) + "-" + strconv.Itoa(try)
+		f, err := os.OpenFile(filepath.Join(dir, prefix+suffix+".tmp"), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0666)
+		if os.IsExist(err) && try < 100 {
+			continue
+		}
+		return f, err
+	}
+}
+
+// watchSignals removes the temporary file and exits when a signal arrives. It
+// receives the channel as an argument because stopSignals clears the field
+// on another goroutine; closing the channel ends the watch.
+func (o *outputFile) watchSignals(signals <-chan os.Signal) {
+	sig, ok := <-signals
+	if !ok {
+		return
+	}
+
+	_ = o.File.Close()
+	_ = os.Remove(o.File.Name())
+	fmt.Fprintf(os.Stderr, "error: interrupted by %v\n", sig)
+	os.Exit(1)
+}
+
+// Commit closes the temporary file and renames it to its destination.
+func (o *outputFile) Commit() error {
+	o.stopSignals()
+
+	if err := o.File.Close(); err != nil {
+		_ = os.Remove(o.File.Name())
+		return err
+	}
+
+	if err := os.Rename(o.File.Name(), o.path); err != nil {
+		_ = os.Remove(o.File.Name())
+		return err
+	}
+
+	return nil
+}
+
+// Abort closes and removes the temporary file. It is safe to call Abort
+// after Commit, in which case it does nothing.
+func (o *outputFile) Abort() {
+	if o.stopSignals() {
+		_ = o.File.Close()
+		_ = os.Remove(o.File.Name())
+	}
+}
+
+// stopSignals stops signal delivery and reports whether the file was still
+// pending at the time of the call.
+func (o *outputFile) stopSignals() bool {
+	if o.signals == nil {
+		return false
+	}
+
+	signal.Stop(o.signals)
+	close(o.signals)
+	o.signals = nil
+	return true
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOutputFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "dump.lsif")
+
+	aborted, err := createOutputFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aborted.Abort()
+
+	committed, err := createOutputFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := committed.WriteString("{}\n"); err != nil {
+		t.Fatal(err)
+	}
+	if err := committed.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	// Abort after Commit does nothing
+	committed.Abort()
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Name() != "dump.lsif" {
+		var names []string
+		for _, f := range files {
+			names = append(names, f.Name())
+		}
+		t.Fatalf("files after commit and abort: %v, want [dump.lsif]", names)
+	}
+}
+
+func TestOutputFileMode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	created, err := os.Create(filepath.Join(dir, "created.lsif"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = created.Close()
+
+	out, err := createOutputFile(filepath.Join(dir, "dump.lsif"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := out.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The dump has the permissions of files created by os.Create under the
+	// same umask
+	want, err := os.Stat(filepath.Join(dir, "created.lsif"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.Stat(filepath.Join(dir, "dump.lsif"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Mode() != want.Mode() {
+		t.Errorf("dump mode %v, want %v", got.Mode(), want.Mode())
+	}
+}
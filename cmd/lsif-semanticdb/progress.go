@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/index"
+)
+
+var progressModes = []string{"auto", "bar", "dots", "json", "none"}
+
+// progressRenderer displays the progress of the indexer.
+type progressRenderer interface {
+	index.Progress
+
+	// End terminates the progress output before a summary is printed.
+	End()
+}
+
+// newProgressRenderer creates the renderer for the given mode. In auto mode
+// a progress bar is drawn on terminals and dots are printed otherwise, unless
+// verbose output is enabled.
+func newProgressRenderer(mode string, verbose bool, w io.Writer) (progressRenderer, error) {
+	if mode == "auto" {
+		switch {
+		case verbose:
+			mode = "none"
+		case isTerminal(w):
+			mode = "bar"
+		default:
+			mode = "dots"
+		}
+	}
+
+	switch mode {
+	case "bar":
+		return &barProgress{w: w}, nil
+	case "dots":
+		return &dotsProgress{w: w}, nil
+	case "json":
+		return &jsonProgress{encoder: json.NewEncoder(w)}, nil
+	case "none":
+		return noProgress{}, nil
+	}
+
+	return nil, fmt.Errorf("unknown progress mode %q", mode)
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// noProgress discards all progress events.
+type noProgress struct{}
+
+func (noProgress) Report(event index.ProgressEvent) {}
+func (noProgress) End()                             {}
+
+// dotsProgress prints a dot for each processed item.
+type dotsProgress struct {
+	w       io.Writer
+	printed bool
+}
+
+func (p *dotsProgress) Report(event index.ProgressEvent) {
+	if event.Done > 0 && !event.Finished {
+		fmt.Fprint(p.w, ".")
+		p.printed = true
+	}
+}
+
+func (p *dotsProgress) End() {
+	if p.printed {
+		fmt.Fprint(p.w, "\n\n")
+		p.printed = false
+	}
+}
+
+// barRedrawInterval limits how often the progress bar is redrawn.
+const barRedrawInterval = 100 * time.Millisecond
+
+const barWidth = 30
+
+// barProgress redraws a single line progress bar for each phase.
+type barProgress struct {
+	w        io.Writer
+	lastDraw time.Time
+}
+
+func (p *barProgress) Report(event index.ProgressEvent) {
+	if !event.Finished && event.Done > 0 && time.Since(p.lastDraw) < barRedrawInterval {
+		return
+	}
+	p.lastDraw = time.Now()
+
+	elapsed := event.Elapsed.Round(time.Millisecond)
+	if event.Total > 0 {
+		filled := barWidth * event.Done / event.Total
+		bar := strings.Repeat("=", filled) + strings.Repeat(" ", barWidth-filled)
+		fmt.Fprintf(p.w, "\r%-12s [%s] %d/%d %s", event.Phase, bar, event.Done, event.Total, elapsed)
+	} else {
+		fmt.Fprintf(p.w, "\r%-12s %d %s", event.Phase, event.Done, elapsed)
+	}
+
+	if event.Finished {
+		fmt.Fprintln(p.w)
+	}
+}
+
+func (p *barProgress) End() {}
+
+// jsonProgressInterval limits how often intermediate events are written in
+// JSON mode. The first and last event of each phase are always written.
+const jsonProgressInterval = time.Second
+
+// jsonProgress writes progress events as JSON lines.
+type jsonProgress struct {
+	encoder   *json.Encoder
+	lastWrite time.Time
+}
+
+type jsonProgressEvent struct {
+	Phase     string `json:"phase"`
+	Done      int    `json:"done"`
+	Total     int    `json:"total"`
+	ElapsedMS int64  `json:"elapsedMs"`
+	Finished  bool   `json:"finished"`
+}
+
+func (p *jsonProgress) Report(event index.ProgressEvent) {
+	if !event.Finished && event.Done > 0 && time.Since(p.lastWrite) < jsonProgressInterval {
+		return
+	}
+	p.lastWrite = time.Now()
+
+	_ = p.encoder.Encode(jsonProgressEvent{
+		Phase:     event.Phase,
+		Done:      event.Done,
+		Total:     event.Total,
+		ElapsedMS: int64(event.Elapsed / time.Millisecond),
+		Finished:  event.Finished,
+	})
+}
+
+func (p *jsonProgress) End() {}
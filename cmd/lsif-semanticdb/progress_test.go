@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/index"
+)
+
+// progressEvents is a phase whose intermediate event is reported right after
+// the first one, so that the bar and JSON renderers skip it.
+var progressEvents = []index.ProgressEvent{
+	{Phase: "documents", Done: 0, Total: 2},
+	{Phase: "documents", Done: 1, Total: 2, Elapsed: time.Millisecond},
+	{Phase: "documents", Done: 2, Total: 2, Elapsed: 2 * time.Millisecond, Finished: true},
+	{Phase: "linking", Done: 0},
+	{Phase: "linking", Done: 3, Elapsed: time.Millisecond, Finished: true},
+}
+
+func TestProgressRenderer(t *testing.T) {
+	for _, test := range []struct {
+		mode    string
+		verbose bool
+		want    string
+	}{
+		{
+			mode: "bar",
+			want: "\rdocuments    [                              ] 0/2 0s" +
+				"\rdocuments    [==============================] 2/2 2ms\n" +
+				"\rlinking      0 0s" +
+				"\rlinking      3 1ms\n",
+		},
+		{
+			mode: "dots",
+			want: ".\n\n",
+		},
+		{
+			mode: "json",
+			want: `{"phase":"documents","done":0,"total":2,"elapsedMs":0,"finished":false}` + "\n" +
+				`{"phase":"documents","done":2,"total":2,"elapsedMs":2,"finished":true}` + "\n" +
+				`{"phase":"linking","done":0,"total":0,"elapsedMs":0,"finished":false}` + "\n" +
+				`{"phase":"linking","done":3,"total":0,"elapsedMs":1,"finished":true}` + "\n",
+		},
+		{
+			mode: "none",
+			want: "",
+		},
+		{
+			// Dots are printed when the output is not a terminal
+			mode: "auto",
+			want: ".\n\n",
+		},
+		{
+			mode:    "auto",
+			verbose: true,
+			want:    "",
+		},
+	} {
+		var buf bytes.Buffer
+		progress, err := newProgressRenderer(test.mode, test.verbose, &buf)
+		if err != nil {
+			t.Fatalf("newProgressRenderer(%q): %v", test.mode, err)
+		}
+
+		for _, event := range progressEvents {
+			progress.Report(event)
+		}
+		progress.End()
+
+		if got := buf.String(); got != test.want {
+			t.Errorf("mode %q, verbose %v: got %q, want %q", test.mode, test.verbose, got, test.want)
+		}
+	}
+}
+
+func TestProgressRendererUnknownMode(t *testing.T) {
+	if _, err := newProgressRenderer("spinner", false, &bytes.Buffer{}); err == nil {
+		t.Error("expected an error for an unknown mode")
+	}
+}
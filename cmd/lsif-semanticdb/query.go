@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/sourcegraph/lsif-semanticdb/internal/index"
+	"github.com/sourcegraph/lsif-semanticdb/internal/lsif"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+// Kinds of queries answered by the query command.
+const (
+	queryDefinition = "definition"
+	queryReferences = "references"
+	queryHover      = "hover"
+)
+
+type queryOptions struct {
+	*globalOptions
+
+	semanticdbDirs     []string
+	excludeDirs        []string
+	dumpFile           string
+	sourceRoot         string
+	language           string
+	includeDeclaration bool
+	kind               string
+	position           string
+}
+
+// newQueryCommand creates the query command. The SemanticDB directories of
+// the config file are used if neither --semanticdbDir nor --dump is given.
+func newQueryCommand(app *kingpin.Application, global *globalOptions, cfg *config) *command {
+	opts := &queryOptions{globalOptions: global}
+
+	clause := app.Command("query", "Print the definition, references or hover text of the symbol at a position.")
+	clause.Flag("semanticdbDir", "Answer the query from the SemanticDB files in this directory. Glob patterns are expanded.").StringsVar(&opts.semanticdbDirs)
+	clause.Flag("excludeDir", "Skip the SemanticDB directories matching this glob pattern.").Default(cfg.ExcludeDirs...).StringsVar(&opts.excludeDirs)
+	clause.Flag("dump", "Answer the query from this LSIF dump.").StringVar(&opts.dumpFile)
+	clause.Flag("sourceRoot", "The directory SemanticDB document URIs are relative to, used to resolve absolute file paths.").Default(orDefault(cfg.SourceRoot, ".")).StringVar(&opts.sourceRoot)
+	clause.Flag("language", "The language of the project when answering from SemanticDB files: scala or java.").Default(orDefault(cfg.Language, index.LanguageScala)).EnumVar(&opts.language, index.LanguageScala, index.LanguageJava)
+	clause.Flag("includeDeclaration", "Include the definition in the references.").Default("true").BoolVar(&opts.includeDeclaration)
+	clause.Arg("kind", "The kind of query: definition, references or hover.").Required().EnumVar(&opts.kind, queryDefinition, queryReferences, queryHover)
+	clause.Arg("position", "The position as file:line:column, with 1-based line and column numbers.").Required().StringVar(&opts.position)
+
+	return &command{
+		clause: clause,
+		run: func() error {
+			if len(opts.semanticdbDirs) == 0 && opts.dumpFile == "" {
+				opts.semanticdbDirs = cfg.SemanticdbDirs
+			}
+			return runQuery(opts)
+		},
+	}
+}
+
+func runQuery(opts *queryOptions) error {
+	if (len(opts.semanticdbDirs) == 0) == (opts.dumpFile == "") {
+		return errors.New("query: exactly one of --semanticdbDir and --dump must be given")
+	}
+
+	uri, pos, err := opts.parsePosition()
+	if err != nil {
+		return err
+	}
+
+	var lines []string
+	if opts.dumpFile != "" {
+		lines, err = queryDump(opts, uri, pos)
+	} else {
+		lines, err = querySemanticDB(opts, uri, pos)
+	}
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("write query result: %v", err)
+	}
+	return nil
+}
+
+// parsePosition parses a file:line:column position into a document URI and a
+// 0-based position. Absolute file paths are made relative to the source root.
+func (opts *queryOptions) parsePosition() (string, protocol.Pos, error) {
+	invalid := fmt.Errorf("invalid position %q: expected file:line:column", opts.position)
+
+	i := strings.LastIndex(opts.position, ":")
+	if i < 0 {
+		return "", protocol.Pos{}, invalid
+	}
+	j := strings.LastIndex(opts.position[:i], ":")
+	if j <= 0 {
+		return "", protocol.Pos{}, invalid
+	}
+
+	line, err := strconv.Atoi(opts.position[j+1 : i])
+	if err != nil || line < 1 {
+		return "", protocol.Pos{}, invalid
+	}
+	column, err := strconv.Atoi(opts.position[i+1:])
+	if err != nil || column < 1 {
+		return "", protocol.Pos{}, invalid
+	}
+
+	file := opts.position[:j]
+	if filepath.IsAbs(file) {
+		sourceRoot, err := filepath.Abs(opts.sourceRoot)
+		if err != nil {
+			return "", protocol.Pos{}, fmt.Errorf("get abspath of source root: %v", err)
+		}
+		if rel, err := filepath.Rel(sourceRoot, file); err == nil && !strings.HasPrefix(rel, "..") {
+			file = rel
+		}
+	}
+
+	return filepath.ToSlash(file), protocol.Pos{Line: line - 1, Character: column - 1}, nil
+}
+
+// queryDump answers the query from the navigation data of an LSIF dump.
+func queryDump(opts *queryOptions, uri string, pos protocol.Pos) ([]string, error) {
+	documents, err := readDocuments(opts.dumpFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryDocuments(opts, documents, uri, pos), nil
+}
+
+// querySemanticDB answers the query from SemanticDB files. They are indexed
+// into an in-memory dump, so symbols are resolved exactly as in the dumps
+// written by the index command.
+func querySemanticDB(opts *queryOptions, uri string, pos protocol.Pos) ([]string, error) {
+	dirs, err := resolveDirs(opts.semanticdbDirs, opts.excludeDirs)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	indexer := index.NewIndexer(
+		dirs,
+		protocol.ToolInfo{Name: "lsif-semanticdb", Version: version},
+		&buf,
+		index.Options{
+			SourceRoot: opts.sourceRoot,
+			Language:   opts.language,
+			Logger:     opts.logger,
+		},
+	)
+	if _, err := indexer.Index(); err != nil {
+		return nil, fmt.Errorf("index: %v", err)
+	}
+
+	elements, err := lsif.Read(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("read dump: %v", err)
+	}
+	documents, err := lsif.Documents(elements)
+	if err != nil {
+		return nil, fmt.Errorf("read dump: %v", err)
+	}
+
+	return queryDocuments(opts, documents, uri, pos), nil
+}
+
+// queryDocuments answers the query from the navigation data of the documents
+// of a dump.
+func queryDocuments(opts *queryOptions, documents []*lsif.Document, uri string, pos protocol.Pos) []string {
+	r := lsif.Lookup(documents, uri, pos)
+	if r == nil {
+		return nil
+	}
+
+	var lines []string
+	switch opts.kind {
+	case queryDefinition:
+		for _, location := range r.Definitions {
+			lines = append(lines, location.String())
+		}
+
+	case queryReferences:
+		definitions := map[lsif.Location]bool{}
+		for _, location := range r.Definitions {
+			definitions[location] = true
+		}
+		for _, location := range r.References {
+			if opts.includeDeclaration || !definitions[location] {
+				lines = append(lines, location.String())
+			}
+		}
+
+	case queryHover:
+		lines = r.Hover
+	}
+
+	return lines
+}
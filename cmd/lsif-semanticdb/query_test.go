@@ -0,0 +1,109 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/index"
+	"github.com/sourcegraph/lsif-semanticdb/internal/log"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+const queryTestDocuments = `documents {
+  schema: SEMANTICDB4
+  uri: "a/Counter.scala"
+  text: "package a\nclass Counter {\n  var count = 0\n}\n"
+  language: SCALA
+  symbols { symbol: "a/Counter#count()." kind: METHOD display_name: "count" }
+  occurrences { range { start_line: 1 start_character: 6 end_line: 1 end_character: 13 } symbol: "a/Counter#" role: DEFINITION }
+  occurrences { range { start_line: 2 start_character: 6 end_line: 2 end_character: 11 } symbol: "a/Counter#count()." role: DEFINITION }
+}
+documents {
+  schema: SEMANTICDB4
+  uri: "a/Main.scala"
+  text: "package a\nobject Main {\n  new Counter().count = 1\n}\n"
+  language: SCALA
+  occurrences { range { start_line: 2 start_character: 6 end_line: 2 end_character: 13 } symbol: "a/Counter#" role: REFERENCE }
+  occurrences { range { start_line: 2 start_character: 16 end_line: 2 end_character: 21 } symbol: "a/Counter#count_=()." role: REFERENCE }
+}
+`
+
+func TestQuerySemanticDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	semanticdbDir := filepath.Join(dir, "META-INF", "semanticdb")
+	if err := os.MkdirAll(semanticdbDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(semanticdbDir, "a.semanticdb.textproto"), []byte(queryTestDocuments), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		kind               string
+		includeDeclaration bool
+		pos                protocol.Pos
+		want               []string
+	}{
+		{queryDefinition, true, protocol.Pos{Line: 2, Character: 8}, []string{"a/Counter.scala:2:7-2:14"}},
+		// Setters resolve to their getter like in the dumps of the index
+		// command
+		{queryDefinition, true, protocol.Pos{Line: 2, Character: 17}, []string{"a/Counter.scala:3:7-3:12"}},
+		{queryReferences, false, protocol.Pos{Line: 2, Character: 17}, []string{"a/Main.scala:3:17-3:22"}},
+		{queryHover, true, protocol.Pos{Line: 4, Character: 0}, nil},
+	}
+
+	for _, testCase := range testCases {
+		opts := &queryOptions{
+			globalOptions:      &globalOptions{logger: log.Nop},
+			semanticdbDirs:     []string{dir},
+			sourceRoot:         dir,
+			language:           index.LanguageScala,
+			kind:               testCase.kind,
+			includeDeclaration: testCase.includeDeclaration,
+		}
+
+		got, err := querySemanticDB(opts, "a/Main.scala", testCase.pos)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("%s at %v = %v, want %v", testCase.kind, testCase.pos, got, testCase.want)
+		}
+	}
+}
+
+func TestParsePosition(t *testing.T) {
+	testCases := []struct {
+		position string
+		uri      string
+		pos      protocol.Pos
+	}{
+		{"a/A.scala:1:1", "a/A.scala", protocol.Pos{}},
+		{"/src/a/A.scala:3:7", "a/A.scala", protocol.Pos{Line: 2, Character: 6}},
+		{"/other/A.scala:3:7", "/other/A.scala", protocol.Pos{Line: 2, Character: 6}},
+		{"C:/a/A.scala:3:7", "C:/a/A.scala", protocol.Pos{Line: 2, Character: 6}},
+	}
+
+	for _, testCase := range testCases {
+		opts := &queryOptions{sourceRoot: "/src", position: testCase.position}
+		uri, pos, err := opts.parsePosition()
+		if err != nil || uri != testCase.uri || pos != testCase.pos {
+			t.Errorf("parsePosition(%q) = %q, %v, %v, want %q, %v", testCase.position, uri, pos, err, testCase.uri, testCase.pos)
+		}
+	}
+
+	for _, position := range []string{"A.scala", "A.scala:1", ":1:1", "A.scala:0:1", "A.scala:1:x"} {
+		opts := &queryOptions{sourceRoot: "/src", position: position}
+		if _, _, err := opts.parsePosition(); err == nil {
+			t.Errorf("expected an error for %q", position)
+		}
+	}
+}
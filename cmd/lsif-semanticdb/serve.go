@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/sourcegraph/lsif-semanticdb/internal/lsp"
+	"github.com/sourcegraph/lsif-semanticdb/internal/navigation"
+)
+
+type serveOptions struct {
+	*globalOptions
+	semanticdbDirs []string
+	excludeDirs    []string
+	sourceRoot     string
+}
+
+func newServeCommand(app *kingpin.Application, global *globalOptions, cfg *config) *command {
+	opts := &serveOptions{globalOptions: global}
+
+	clause := app.Command("serve", "Answer LSP navigation requests from SemanticDB files over stdio.")
+	clause.Flag("semanticdbDir", "Specifies the directory of the META-INF/semanticdb directory. Glob patterns are expanded.").Default(cfg.SemanticdbDirs...).StringsVar(&opts.semanticdbDirs)
+	clause.Flag("excludeDir", "Skip the SemanticDB directories matching this glob pattern.").Default(cfg.ExcludeDirs...).StringsVar(&opts.excludeDirs)
+	clause.Flag("sourceRoot", "The directory SemanticDB document URIs are relative to.").Default(orDefault(cfg.SourceRoot, ".")).StringVar(&opts.sourceRoot)
+
+	return &command{
+		clause: clause,
+		run:    func() error { return runServe(opts) },
+	}
+}
+
+func runServe(opts *serveOptions) error {
+	dirs, err := resolveDirs(opts.semanticdbDirs, opts.excludeDirs)
+	if err != nil {
+		return err
+	}
+
+	sourceRoot, err := filepath.Abs(opts.sourceRoot)
+	if err != nil {
+		return fmt.Errorf("get abspath of source root: %v", err)
+	}
+
+	index, err := navigation.Load(dirs)
+	if err != nil {
+		return err
+	}
+
+	// Stdout carries the protocol, the logger writes to stderr or the log file
+	return lsp.NewServer(index, sourceRoot, version, opts.logger).Serve(os.Stdin, os.Stdout)
+}
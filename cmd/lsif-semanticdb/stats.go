@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/alecthomas/kingpin"
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+	"github.com/sourcegraph/lsif-semanticdb/internal/stats"
+)
+
+type statsOptions struct {
+	semanticdbDirs []string
+	excludeDirs    []string
+	format         string
+	top            int
+}
+
+func newStatsCommand(app *kingpin.Application, cfg *config) *command {
+	opts := &statsOptions{}
+
+	clause := app.Command("stats", "Report statistics of SemanticDB files without indexing them.")
+	clause.Flag("semanticdbDir", "Specifies the directory of the META-INF/semanticdb directory. Glob patterns are expanded.").Default(cfg.SemanticdbDirs...).StringsVar(&opts.semanticdbDirs)
+	clause.Flag("excludeDir", "Skip the SemanticDB directories matching this glob pattern.").Default(cfg.ExcludeDirs...).StringsVar(&opts.excludeDirs)
+	clause.Flag("format", "The output format.").Default("text").EnumVar(&opts.format, "text", "json")
+	clause.Flag("top", "The number of files and unresolved symbols listed, or -1 to list all.").Default("10").IntVar(&opts.top)
+
+	return &command{
+		clause: clause,
+		run:    func() error { return runStats(opts) },
+	}
+}
+
+func runStats(opts *statsOptions) error {
+	dirs, err := resolveDirs(opts.semanticdbDirs, opts.excludeDirs)
+	if err != nil {
+		return err
+	}
+
+	collector := stats.NewCollector()
+	err = semanticdb.Walk(dirs, func(path string, textDocuments *pb.TextDocuments) error {
+		for _, document := range textDocuments.GetDocuments() {
+			collector.Add(document)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	report := collector.Report(opts.top)
+
+	if opts.format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	return stats.WriteText(os.Stdout, report)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/sourcegraph/lsif-semanticdb/internal/lsif"
+	"github.com/sourcegraph/lsif-semanticdb/internal/validate"
+)
+
+type validateOptions struct {
+	dumpFile string
+	limit    int
+}
+
+func newValidateCommand(app *kingpin.Application) *command {
+	opts := &validateOptions{}
+
+	clause := app.Command("validate", "Check the structure of an LSIF dump and print a JSON report.")
+	clause.Flag("limit", "The maximum number of problems listed per check (0 lists all).").Default("100").IntVar(&opts.limit)
+	clause.Arg("dump", "The LSIF dump to validate.").Default("dump.lsif").StringVar(&opts.dumpFile)
+
+	return &command{
+		clause: clause,
+		run:    func() error { return runValidate(opts) },
+	}
+}
+
+func runValidate(opts *validateOptions) error {
+	f, err := os.Open(opts.dumpFile)
+	if err != nil {
+		return fmt.Errorf("open dump file: %v", err)
+	}
+	defer f.Close()
+
+	elements, err := lsif.Read(f)
+	if err != nil {
+		return fmt.Errorf("read dump file: %v", err)
+	}
+
+	report := validate.Validate(elements, opts.limit)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("write report: %v", err)
+	}
+
+	if !report.Valid() {
+		return fmt.Errorf("%s is invalid: %d error(s)", opts.dumpFile, report.ErrorCount())
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package index
+
+import (
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol/writer"
+)
+
+// emitter creates vertices and edges with consecutive identifiers and passes
+// them to a JSONWriter. It follows the emitter of the protocol library and
+// adds what that library does not support: the position encoding of the
+// metaData vertex, document contents and implementation results.
+type emitter struct {
+	w  writer.JSONWriter
+	id uint64
+}
+
+func newEmitter(w writer.JSONWriter) *emitter {
+	return &emitter{w: w}
+}
+
+type element struct {
+	ID    uint64 `json:"id"`
+	Type  string `json:"type"`
+	Label string `json:"label"`
+}
+
+type metaData struct {
+	element
+	Version          string            `json:"version"`
+	ProjectRoot      string            `json:"projectRoot"`
+	PositionEncoding string            `json:"positionEncoding"`
+	ToolInfo         protocol.ToolInfo `json:"toolInfo"`
+}
+
+type project struct {
+	element
+	Kind string `json:"kind"`
+}
+
+type document struct {
+	element
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Contents   string `json:"contents,omitempty"`
+}
+
+type rangeVertex struct {
+	element
+	Start protocol.Pos `json:"start"`
+	End   protocol.Pos `json:"end"`
+}
+
+type hoverResult struct {
+	element
+	Result hoverResultContents `json:"result"`
+}
+
+type hoverResultContents struct {
+	Contents []protocol.MarkedString `json:"contents"`
+}
+
+type moniker struct {
+	element
+	Kind       string `json:"kind"`
+	Scheme     string `json:"scheme"`
+	Identifier string `json:"identifier"`
+}
+
+type packageInformation struct {
+	element
+	Name    string `json:"name"`
+	Manager string `json:"manager"`
+	Version string `json:"version"`
+}
+
+type edge struct {
+	element
+	OutV uint64 `json:"outV"`
+	InV  uint64 `json:"inV"`
+}
+
+type multiEdge struct {
+	element
+	OutV uint64   `json:"outV"`
+	InVs []uint64 `json:"inVs"`
+}
+
+type itemEdge struct {
+	element
+	OutV     uint64   `json:"outV"`
+	InVs     []uint64 `json:"inVs"`
+	Document uint64   `json:"document"`
+	Property string   `json:"property,omitempty"`
+}
+
+func (e *emitter) newElement(elementType, label string) element {
+	e.id++
+	return element{ID: e.id, Type: elementType, Label: label}
+}
+
+func (e *emitter) vertex(label string) element { return e.newElement("vertex", label) }
+func (e *emitter) edge(label string) element   { return e.newElement("edge", label) }
+
+func (e *emitter) emit(v interface{}) uint64 {
+	e.w.Write(v)
+	return e.id
+}
+
+// EmitMetaData emits the metaData vertex declaring the encoding of the
+// character offsets of ranges, e.g. "utf-16".
+func (e *emitter) EmitMetaData(root string, info protocol.ToolInfo, positionEncoding string) uint64 {
+	return e.emit(metaData{e.vertex("metaData"), protocol.Version, root, positionEncoding, info})
+}
+
+func (e *emitter) EmitProject(languageID string) uint64 {
+	return e.emit(project{e.vertex("project"), languageID})
+}
+
+// EmitDocument emits a document vertex. The contents are base64 encoded and
+// omitted if empty.
+func (e *emitter) EmitDocument(languageID, path, contents string) uint64 {
+	return e.emit(document{e.vertex("document"), "file://" + path, languageID, contents})
+}
+
+func (e *emitter) EmitRange(start, end protocol.Pos) uint64 {
+	return e.emit(rangeVertex{e.vertex("range"), start, end})
+}
+
+func (e *emitter) EmitResultSet() uint64        { return e.emit(e.vertex("resultSet")) }
+func (e *emitter) EmitDefinitionResult() uint64 { return e.emit(e.vertex("definitionResult")) }
+func (e *emitter) EmitReferenceResult() uint64  { return e.emit(e.vertex("referenceResult")) }
+
+func (e *emitter) EmitImplementationResult() uint64 {
+	return e.emit(e.vertex("implementationResult"))
+}
+
+func (e *emitter) EmitHoverResult(contents []protocol.MarkedString) uint64 {
+	return e.emit(hoverResult{e.vertex("hoverResult"), hoverResultContents{contents}})
+}
+
+func (e *emitter) EmitMoniker(kind, scheme, identifier string) uint64 {
+	return e.emit(moniker{e.vertex("moniker"), kind, scheme, identifier})
+}
+
+func (e *emitter) EmitPackageInformation(name, manager, version string) uint64 {
+	return e.emit(packageInformation{e.vertex("packageInformation"), name, manager, version})
+}
+
+func (e *emitter) emitEdge(label string, outV, inV uint64) uint64 {
+	return e.emit(edge{e.edge(label), outV, inV})
+}
+
+func (e *emitter) EmitTextDocumentDefinition(outV, inV uint64) uint64 {
+	return e.emitEdge("textDocument/definition", outV, inV)
+}
+
+func (e *emitter) EmitTextDocumentReferences(outV, inV uint64) uint64 {
+	return e.emitEdge("textDocument/references", outV, inV)
+}
+
+func (e *emitter) EmitTextDocumentImplementation(outV, inV uint64) uint64 {
+	return e.emitEdge("textDocument/implementation", outV, inV)
+}
+
+func (e *emitter) EmitTextDocumentHover(outV, inV uint64) uint64 {
+	return e.emitEdge("textDocument/hover", outV, inV)
+}
+
+func (e *emitter) EmitMonikerEdge(outV, inV uint64) uint64 {
+	return e.emitEdge("moniker", outV, inV)
+}
+
+func (e *emitter) EmitPackageInformationEdge(outV, inV uint64) uint64 {
+	return e.emitEdge("packageInformation", outV, inV)
+}
+
+func (e *emitter) EmitNext(outV, inV uint64) uint64 {
+	return e.emitEdge("next", outV, inV)
+}
+
+func (e *emitter) EmitContains(outV uint64, inVs []uint64) uint64 {
+	return e.emit(multiEdge{e.edge("contains"), outV, inVs})
+}
+
+func (e *emitter) EmitItem(outV uint64, inVs []uint64, docID uint64) uint64 {
+	return e.emit(itemEdge{e.edge("item"), outV, inVs, docID, ""})
+}
+
+func (e *emitter) EmitItemOfDefinitions(outV uint64, inVs []uint64, docID uint64) uint64 {
+	return e.emit(itemEdge{e.edge("item"), outV, inVs, docID, "definitions"})
+}
+
+func (e *emitter) EmitItemOfReferences(outV uint64, inVs []uint64, docID uint64) uint64 {
+	return e.emit(itemEdge{e.edge("item"), outV, inVs, docID, "references"})
+}
+
+// NumElements returns the number of elements emitted so far.
+func (e *emitter) NumElements() uint64 {
+	return e.id
+}
+
+// Flush writes the emitted elements to the underlying writer.
+func (e *emitter) Flush() error {
+	return e.w.Flush()
+}
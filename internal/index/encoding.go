@@ -0,0 +1,98 @@
+package index
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+// Encodings of the character offsets of emitted ranges. SemanticDB counts
+// UTF-16 code units, so PositionEncodingUTF16 emits ranges unchanged.
+const (
+	PositionEncodingUTF16     = "utf16"
+	PositionEncodingUTF8      = "utf8"
+	PositionEncodingCodePoint = "codepoint"
+)
+
+// PositionEncodings lists the supported position encodings.
+var PositionEncodings = []string{PositionEncodingUTF16, PositionEncodingUTF8, PositionEncodingCodePoint}
+
+func checkPositionEncoding(encoding string) error {
+	for _, e := range PositionEncodings {
+		if encoding == e {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown position encoding %q", encoding)
+}
+
+// lsifPositionEncoding returns the name of a position encoding used by the
+// positionEncoding field of the metaData vertex, which follows the LSP.
+func lsifPositionEncoding(encoding string) string {
+	switch encoding {
+	case PositionEncodingUTF8:
+		return "utf-8"
+	case PositionEncodingCodePoint:
+		return "utf-32"
+	}
+	return "utf-16"
+}
+
+// positionConverter converts the UTF-16 character offsets of a document to
+// another encoding. A nil converter leaves offsets unchanged.
+type positionConverter struct {
+	encoding string
+	lines    []string
+}
+
+// newPositionConverter returns a converter for the lines of the given text,
+// or nil if no conversion is needed. Documents with occurrences but without
+// text are not indexed in other encodings.
+func newPositionConverter(encoding, text string) *positionConverter {
+	if encoding == PositionEncodingUTF16 || text == "" {
+		return nil
+	}
+
+	return &positionConverter{encoding: encoding, lines: strings.Split(text, "\n")}
+}
+
+// character converts the character offset of a position. Offsets within a
+// surrogate pair are moved to the start of its code point, and offsets beyond
+// the end of the line are kept beyond it by the same amount.
+func (c *positionConverter) character(line, character int) int {
+	if c == nil || line < 0 || line >= len(c.lines) {
+		return character
+	}
+
+	text := c.lines[line]
+	offset := func(bytes, codePoints int) int {
+		if c.encoding == PositionEncodingUTF8 {
+			return bytes
+		}
+		return codePoints
+	}
+
+	units, codePoints := 0, 0
+	for i, r := range text {
+		n := semanticdb.UTF16Len(r)
+		if units+n > character {
+			return offset(i, codePoints)
+		}
+		units += n
+		codePoints++
+	}
+
+	return offset(len(text), codePoints) + character - units
+}
+
+// convertRange converts a SemanticDB range of the document into an LSIF
+// range in the position encoding of the dump.
+func (fi *fileInfo) convertRange(r *pb.Range) (protocol.Pos, protocol.Pos) {
+	start, end := convertRange(r)
+	start.Character = fi.positions.character(start.Line, start.Character)
+	end.Character = fi.positions.character(end.Line, end.Character)
+	return start, end
+}
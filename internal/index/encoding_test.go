@@ -0,0 +1,148 @@
+package index
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/lsif"
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+func TestPositionEncoding(t *testing.T) {
+	// π is one UTF-16 code unit and two UTF-8 bytes long, the emoji two UTF-16
+	// code units and four UTF-8 bytes long
+	text := "object Main {\n  val π = \"😀\"; val ü = π\n}\n"
+	occurrence := func(startCharacter, endCharacter int32, symbol string, role pb.SymbolOccurrence_Role) *pb.SymbolOccurrence {
+		return &pb.SymbolOccurrence{
+			Range:  &pb.Range{StartLine: 1, StartCharacter: startCharacter, EndLine: 1, EndCharacter: endCharacter},
+			Symbol: symbol,
+			Role:   role,
+		}
+	}
+	document := func() *pb.TextDocument {
+		return &pb.TextDocument{
+			Uri:      "Main.scala",
+			Text:     text,
+			Language: pb.Language_SCALA,
+			Symbols: []*pb.SymbolInformation{
+				{Symbol: "Main.π.", Kind: pb.SymbolInformation_METHOD, DisplayName: "π"},
+				{Symbol: "Main.ü.", Kind: pb.SymbolInformation_METHOD, DisplayName: "ü"},
+			},
+			Occurrences: []*pb.SymbolOccurrence{
+				occurrence(6, 7, "Main.π.", pb.SymbolOccurrence_DEFINITION),
+				occurrence(20, 21, "Main.ü.", pb.SymbolOccurrence_DEFINITION),
+				occurrence(24, 25, "Main.π.", pb.SymbolOccurrence_REFERENCE),
+			},
+		}
+	}
+
+	testCases := []struct {
+		encoding string
+		declared string
+		want     [][2]int
+	}{
+		{PositionEncodingUTF16, "utf-16", [][2]int{{6, 7}, {20, 21}, {24, 25}}},
+		{PositionEncodingUTF8, "utf-8", [][2]int{{6, 8}, {23, 25}, {28, 30}}},
+		{PositionEncodingCodePoint, "utf-32", [][2]int{{6, 7}, {19, 20}, {23, 24}}},
+	}
+
+	for _, testCase := range testCases {
+		var buf bytes.Buffer
+		i := NewIndexer(nil, protocol.ToolInfo{Name: "lsif-semanticdb"}, &buf, Options{PositionEncoding: testCase.encoding}).(*indexer)
+		if err := i.loadDatabase("Main.semanticdb", &pb.TextDocuments{Documents: []*pb.TextDocument{document()}}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := i.index(); err != nil {
+			t.Fatal(err)
+		}
+
+		// The metaData vertex declares the encoding of the dump
+		var metaData struct {
+			Label            string `json:"label"`
+			PositionEncoding string `json:"positionEncoding"`
+		}
+		if err := json.Unmarshal(bytes.SplitN(buf.Bytes(), []byte("\n"), 2)[0], &metaData); err != nil {
+			t.Fatal(err)
+		}
+		if metaData.Label != "metaData" || metaData.PositionEncoding != testCase.declared {
+			t.Errorf("%s: %s vertex declares %q, want %q", testCase.encoding, metaData.Label, metaData.PositionEncoding, testCase.declared)
+		}
+
+		elements, err := lsif.Read(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		documents, err := lsif.Documents(elements)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got [][2]int
+		for _, r := range documents[0].Ranges {
+			got = append(got, [2]int{r.Start.Character, r.End.Character})
+		}
+		if !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("%s: ranges %v, want %v", testCase.encoding, got, testCase.want)
+		}
+	}
+}
+
+func TestPositionEncodingWithoutText(t *testing.T) {
+	// The source root does not contain the source file either
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	document := func() *pb.TextDocument {
+		return &pb.TextDocument{
+			Uri:      "Main.scala",
+			Language: pb.Language_SCALA,
+			Occurrences: []*pb.SymbolOccurrence{
+				{Range: &pb.Range{StartLine: 0, StartCharacter: 7, EndLine: 0, EndCharacter: 11}, Symbol: "Main.", Role: pb.SymbolOccurrence_DEFINITION},
+			},
+		}
+	}
+
+	// Only UTF-16 ranges can be emitted without converting them
+	for encoding, want := range map[string]int{PositionEncodingUTF16: 1, PositionEncodingUTF8: 0, PositionEncodingCodePoint: 0} {
+		var buf bytes.Buffer
+		i := NewIndexer(nil, protocol.ToolInfo{Name: "lsif-semanticdb"}, &buf, Options{SourceRoot: dir, PositionEncoding: encoding}).(*indexer)
+		if err := i.loadDatabase("Main.semanticdb", &pb.TextDocuments{Documents: []*pb.TextDocument{document()}}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := i.index(); err != nil {
+			t.Fatal(err)
+		}
+
+		elements, err := lsif.Read(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		documents, err := lsif.Documents(elements)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(documents) != want {
+			t.Errorf("%s: %d documents, want %d", encoding, len(documents), want)
+		}
+	}
+}
+
+func TestPositionConverter(t *testing.T) {
+	c := newPositionConverter(PositionEncodingUTF8, "a😀b\n")
+
+	// Offsets within the surrogate pair of the emoji move to its start, those
+	// beyond the end of the line stay beyond it
+	for character, want := range []int{0, 1, 1, 5, 6, 7} {
+		if got := c.character(0, character); got != want {
+			t.Errorf("character(0, %d) = %d, want %d", character, got, want)
+		}
+	}
+}
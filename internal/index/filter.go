@@ -0,0 +1,202 @@
+package index
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+// Filter selects the documents and symbols that are indexed. The zero value
+// selects everything.
+//
+// Document patterns are globs matched against the whole document URI, where
+// * and ? do not match a slash and ** matches any number of directories, e.g.
+// "**/test/**" or "**/*Generated.scala". A document is indexed if it matches
+// one of the include patterns, or there are none, and matches no exclude
+// pattern. SemanticDB files below a META-INF/semanticdb directory are skipped
+// without being read if the URI implied by their path is not indexed, since
+// the compiler plugins write the document of each source file to the path
+// of its URI. Documents are still matched by their own URI once a file is
+// read, but a file whose documents do not follow this layout is skipped if
+// its path alone is excluded.
+//
+// Symbol prefixes select the global symbols whose occurrences are indexed in
+// the same way, e.g. "scala/" or "shaded/". Local symbols are always indexed.
+type Filter struct {
+	IncludeDocuments []string
+	ExcludeDocuments []string
+	IncludeSymbols   []string
+	ExcludeSymbols   []string
+}
+
+// filter is a compiled Filter.
+type filter struct {
+	includeDocuments []*regexp.Regexp
+	excludeDocuments []*regexp.Regexp
+	includeSymbols   []string
+	excludeSymbols   []string
+}
+
+func compileFilter(f Filter) (*filter, error) {
+	includeDocuments, err := compileGlobs(f.IncludeDocuments)
+	if err != nil {
+		return nil, err
+	}
+	excludeDocuments, err := compileGlobs(f.ExcludeDocuments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filter{
+		includeDocuments: includeDocuments,
+		excludeDocuments: excludeDocuments,
+		includeSymbols:   f.IncludeSymbols,
+		excludeSymbols:   f.ExcludeSymbols,
+	}, nil
+}
+
+// document returns true if the document with the given URI is indexed.
+func (f *filter) document(uri string) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.includeDocuments) > 0 && !matchAny(f.includeDocuments, uri) {
+		return false
+	}
+
+	return !matchAny(f.excludeDocuments, uri)
+}
+
+// file returns true if the SemanticDB file at the given path is read. Files
+// are skipped if the document URI implied by their path is not indexed.
+func (f *filter) file(path string) bool {
+	if f == nil || (len(f.includeDocuments) == 0 && len(f.excludeDocuments) == 0) {
+		return true
+	}
+
+	uri, ok := semanticdb.DocumentURI(path)
+	return !ok || f.document(uri)
+}
+
+// symbol returns true if the occurrences of the given symbol are indexed.
+func (f *filter) symbol(symbol string) bool {
+	if f == nil || semanticdb.IsLocal(symbol) {
+		return true
+	}
+
+	if len(f.includeSymbols) > 0 && !hasAnyPrefix(symbol, f.includeSymbols) {
+		return false
+	}
+
+	return !hasAnyPrefix(symbol, f.excludeSymbols)
+}
+
+// filterSymbols removes the occurrences and information of the symbols that
+// are not indexed from a document.
+func (f *filter) filterSymbols(document *pb.TextDocument) {
+	if f == nil || (len(f.includeSymbols) == 0 && len(f.excludeSymbols) == 0) {
+		return
+	}
+
+	occurrences := document.Occurrences[:0]
+	for _, occurrence := range document.Occurrences {
+		if f.symbol(occurrence.GetSymbol()) {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+	document.Occurrences = occurrences
+
+	symbols := document.Symbols[:0]
+	for _, symbol := range document.Symbols {
+		if f.symbol(symbol.GetSymbol()) {
+			symbols = append(symbols, symbol)
+		}
+	}
+	document.Symbols = symbols
+}
+
+func matchAny(patterns []*regexp.Regexp, s string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func compileGlobs(patterns []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+	for _, pattern := range patterns {
+		re, err := compileGlob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+
+	return compiled, nil
+}
+
+// compileGlob translates a glob pattern into a regular expression matching
+// whole paths.
+func compileGlob(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if strings.HasPrefix(pattern[i:], "**/") {
+				b.WriteString("(.*/)?")
+				i += 2
+			} else if strings.HasPrefix(pattern[i:], "**") {
+				b.WriteString(".*")
+				i++
+			} else {
+				b.WriteString("[^/]*")
+			}
+
+		case '?':
+			b.WriteString("[^/]")
+
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated character class")
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end + 1
+
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
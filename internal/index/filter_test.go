@@ -0,0 +1,186 @@
+package index
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+func TestCompileGlob(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		matches []string
+		misses  []string
+	}{
+		{"**/test/**", []string{"test/A.scala", "src/test/A.scala", "a/b/test/c/A.scala"}, []string{"src/tests/A.scala", "test", "src/A.scala"}},
+		{"**/*Generated.scala", []string{"FooGenerated.scala", "a/b/FooGenerated.scala"}, []string{"a/FooGenerated.java", "a/Generated.scala/b"}},
+		{"src/**", []string{"src/A.scala", "src/a/b/A.scala"}, []string{"other/src/A.scala", "srcA.scala"}},
+		{"src/*.scala", []string{"src/A.scala"}, []string{"src/a/A.scala", "A.scala"}},
+		{"src/?.scala", []string{"src/A.scala"}, []string{"src/AB.scala", "src//.scala"}},
+		{"src/[AB].scala", []string{"src/A.scala", "src/B.scala"}, []string{"src/C.scala"}},
+		{"src/[!A].scala", []string{"src/B.scala"}, []string{"src/A.scala"}},
+		{`src/\*.scala`, []string{"src/*.scala"}, []string{"src/A.scala"}},
+		{"src/a+b(c).scala", []string{"src/a+b(c).scala"}, []string{"src/aab(c).scala"}},
+	}
+
+	for _, testCase := range testCases {
+		re, err := compileGlob(testCase.pattern)
+		if err != nil {
+			t.Fatalf("compileGlob(%q): %v", testCase.pattern, err)
+		}
+		for _, s := range testCase.matches {
+			if !re.MatchString(s) {
+				t.Errorf("%q does not match %q", testCase.pattern, s)
+			}
+		}
+		for _, s := range testCase.misses {
+			if re.MatchString(s) {
+				t.Errorf("%q matches %q", testCase.pattern, s)
+			}
+		}
+	}
+
+	if _, err := compileGlob("src/[A.scala"); err == nil {
+		t.Errorf("expected an error for an unterminated character class")
+	}
+	if _, err := compileFilter(Filter{ExcludeDocuments: []string{"["}}); err == nil {
+		t.Errorf("expected an error for an invalid exclude pattern")
+	}
+}
+
+func TestFilterDocuments(t *testing.T) {
+	f, err := compileFilter(Filter{
+		IncludeDocuments: []string{"src/**"},
+		ExcludeDocuments: []string{"**/test/**"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]bool{
+		"src/A.scala":      true,
+		"src/test/A.scala": false,
+		"other/A.scala":    false,
+	}
+	for uri, want := range testCases {
+		if got := f.document(uri); got != want {
+			t.Errorf("document(%q) = %v, want %v", uri, got, want)
+		}
+	}
+
+	// Files are selected by the URI implied by their path
+	files := map[string]bool{
+		"/out/META-INF/semanticdb/src/A.scala.semanticdb":                true,
+		"/out/META-INF/semanticdb/src/test/A.scala.semanticdb.textproto": false,
+		"/out/META-INF/semanticdb/other/A.scala.semanticdb.json":         false,
+		// Files outside of a META-INF/semanticdb directory are always read
+		"/out/other/A.scala.semanticdb": true,
+	}
+	for path, want := range files {
+		if got := f.file(filepath.FromSlash(path)); got != want {
+			t.Errorf("file(%q) = %v, want %v", path, got, want)
+		}
+	}
+
+	var zero *filter
+	if !zero.document("other/A.scala") || !zero.file("/out/META-INF/semanticdb/other/A.scala.semanticdb") {
+		t.Errorf("a nil filter does not select everything")
+	}
+}
+
+func TestFilterSymbols(t *testing.T) {
+	f, err := compileFilter(Filter{
+		IncludeSymbols: []string{"scala/", "a/"},
+		ExcludeSymbols: []string{"scala/deprecated/"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]bool{
+		"scala/Int#":                 true,
+		"a/Foo#bar().":               true,
+		"scala/deprecated/Foo#":      false,
+		"java/lang/String#":          false,
+		"local0":                     true,
+		"scalaz/Monad#":              false,
+		"scala/deprecatedName/Foo#.": true,
+	}
+	for symbol, want := range testCases {
+		if got := f.symbol(symbol); got != want {
+			t.Errorf("symbol(%q) = %v, want %v", symbol, got, want)
+		}
+	}
+
+	document := &pb.TextDocument{
+		Occurrences: []*pb.SymbolOccurrence{
+			{Symbol: "a/Foo#"},
+			{Symbol: "java/lang/String#"},
+			{Symbol: "local0"},
+		},
+		Symbols: []*pb.SymbolInformation{
+			{Symbol: "a/Foo#"},
+			{Symbol: "scala/deprecated/Foo#"},
+		},
+	}
+	f.filterSymbols(document)
+
+	var occurrences, symbols []string
+	for _, occurrence := range document.Occurrences {
+		occurrences = append(occurrences, occurrence.GetSymbol())
+	}
+	for _, symbol := range document.Symbols {
+		symbols = append(symbols, symbol.GetSymbol())
+	}
+	if want := []string{"a/Foo#", "local0"}; !reflect.DeepEqual(occurrences, want) {
+		t.Errorf("occurrences %v, want %v", occurrences, want)
+	}
+	if want := []string{"a/Foo#"}; !reflect.DeepEqual(symbols, want) {
+		t.Errorf("symbols %v, want %v", symbols, want)
+	}
+}
+
+func TestFilterSkipsFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeDocuments(t, dir, "META-INF/semanticdb/src/A.scala.semanticdb", &pb.TextDocument{Uri: "src/A.scala"})
+	// Excluded files are not decoded
+	invalid := filepath.Join(dir, "META-INF", "semanticdb", "src", "test", "ATest.scala.semanticdb")
+	if err := os.MkdirAll(filepath.Dir(invalid), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(invalid, []byte("not a SemanticDB file"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := compileFilter(Filter{ExcludeDocuments: []string{"**/test/**"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var uris []string
+	err = semanticdb.WalkSelected([]string{dir}, f.file, func(path string, textDocuments *pb.TextDocuments) error {
+		for _, document := range textDocuments.GetDocuments() {
+			uris = append(uris, document.GetUri())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(uris)
+
+	if want := []string{"src/A.scala"}; !reflect.DeepEqual(uris, want) {
+		t.Errorf("read documents %v, want %v", uris, want)
+	}
+}
@@ -0,0 +1,112 @@
+//go:build go1.18
+// +build go1.18
+
+package index
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/lsif"
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+	"github.com/sourcegraph/lsif-semanticdb/internal/validate"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// FuzzLoadDatabase loads arbitrary SemanticDB files.
+func FuzzLoadDatabase(f *testing.F) {
+	addFuzzSeeds(f)
+
+	f.Fuzz(func(t *testing.T, contents []byte) {
+		textDocuments := &pb.TextDocuments{}
+		if err := proto.Unmarshal(contents, textDocuments); err != nil {
+			t.Skip()
+		}
+
+		i := newFuzzIndexer(t, ioutil.Discard)
+		_ = i.loadDatabase("fuzz.semanticdb", textDocuments)
+	})
+}
+
+// FuzzIndex indexes arbitrary SemanticDB files and checks that the dump is
+// structurally valid.
+func FuzzIndex(f *testing.F) {
+	addFuzzSeeds(f)
+
+	f.Fuzz(func(t *testing.T, contents []byte) {
+		textDocuments := &pb.TextDocuments{}
+		if err := proto.Unmarshal(contents, textDocuments); err != nil {
+			t.Skip()
+		}
+
+		var buf bytes.Buffer
+		i := newFuzzIndexer(t, &buf)
+		if err := i.loadDatabase("fuzz.semanticdb", textDocuments); err != nil {
+			t.Skip()
+		}
+		if _, err := i.index(); err != nil {
+			t.Fatalf("index: %v", err)
+		}
+
+		elements, err := lsif.Read(&buf)
+		if err != nil {
+			t.Fatalf("read dump: %v", err)
+		}
+		if _, err := lsif.Documents(elements); err != nil {
+			t.Fatalf("read dump: %v", err)
+		}
+		if report := validate.Validate(elements, 1); !report.Valid() {
+			t.Fatalf("invalid dump: %+v", report.Errors)
+		}
+	})
+}
+
+// newFuzzIndexer creates an indexer whose source root is an empty directory,
+// so that documents without text are not read from disk.
+func newFuzzIndexer(t *testing.T, w io.Writer) *indexer {
+	return NewIndexer(nil, protocol.ToolInfo{Name: "lsif-semanticdb"}, w, Options{SourceRoot: t.TempDir()}).(*indexer)
+}
+
+// addFuzzSeeds adds the SemanticDB files of the golden tests and documents
+// with missing fields or a symbol defined twice to the corpus.
+func addFuzzSeeds(f *testing.F) {
+	paths, err := filepath.Glob("testdata/*/*/META-INF/semanticdb/*" + semanticdb.Extension)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, path := range paths {
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(contents)
+	}
+
+	for _, document := range []*pb.TextDocument{
+		{Uri: "a.scala"},
+		{Uri: "a.scala", Occurrences: []*pb.SymbolOccurrence{
+			{Symbol: "a/A#", Role: pb.SymbolOccurrence_DEFINITION},
+			{Symbol: "a/A#", Role: pb.SymbolOccurrence_REFERENCE},
+		}},
+		{Uri: "a.scala", Text: "object A", Occurrences: []*pb.SymbolOccurrence{
+			{Range: &pb.Range{StartLine: -1, StartCharacter: 7, EndLine: 0, EndCharacter: -8}, Symbol: "a/A.", Role: pb.SymbolOccurrence_DEFINITION},
+			{Range: &pb.Range{StartLine: 0, StartCharacter: 7, EndLine: 0, EndCharacter: 8}, Role: pb.SymbolOccurrence_REFERENCE},
+		}},
+		{Uri: "a.scala", Text: "object A\nobject A\nA", Occurrences: []*pb.SymbolOccurrence{
+			{Range: &pb.Range{StartLine: 0, StartCharacter: 7, EndLine: 0, EndCharacter: 8}, Symbol: "a/A.", Role: pb.SymbolOccurrence_DEFINITION},
+			{Range: &pb.Range{StartLine: 1, StartCharacter: 7, EndLine: 1, EndCharacter: 8}, Symbol: "a/A.", Role: pb.SymbolOccurrence_DEFINITION},
+			{Range: &pb.Range{StartLine: 2, StartCharacter: 0, EndLine: 2, EndCharacter: 1}, Symbol: "a/A.", Role: pb.SymbolOccurrence_REFERENCE},
+		}},
+	} {
+		contents, err := proto.Marshal(&pb.TextDocuments{Documents: []*pb.TextDocument{document}})
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(contents)
+	}
+}
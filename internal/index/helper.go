@@ -14,3 +14,18 @@ func convertRange(r *pb.Range) (start protocol.Pos, end protocol.Pos) {
 			Character: int(r.EndCharacter),
 		}
 }
+
+// documentLanguage returns the LSIF language identifier of a document.
+func documentLanguage(document *pb.TextDocument) string {
+	if document.GetLanguage() == pb.Language_JAVA {
+		return LanguageJava
+	}
+	return LanguageScala
+}
+
+// lookupSymbol returns a function resolving the symbols of a document.
+func lookupSymbol(fi *fileInfo) func(symbol string) *pb.SymbolInformation {
+	return func(symbol string) *pb.SymbolInformation {
+		return fi.symbols[symbol]
+	}
+}
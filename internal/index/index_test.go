@@ -0,0 +1,164 @@
+package index
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/lsif"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// TestGolden indexes each directory below testdata and compares the
+// normalized dump to the dump.golden file of the directory.
+//
+// Each NAME.semanticdb.textproto file of a test directory is the source form
+// of the binary SemanticDB file NAME/META-INF/semanticdb/NAME.semanticdb,
+// and every NAME directory is passed to the indexer. Run the tests with
+// -update after changing a textproto file or the output of the indexer.
+func TestGolden(t *testing.T) {
+	dirs, err := filepath.Glob("testdata/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dir := range dirs {
+		dir := dir
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			testGolden(t, dir)
+		})
+	}
+}
+
+func testGolden(t *testing.T, dir string) {
+	sources, err := filepath.Glob(filepath.Join(dir, "*"+semanticdb.TextprotoExtension))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sources) == 0 {
+		t.Fatalf("no textproto fixtures in %s", dir)
+	}
+
+	var semanticdbDirs []string
+	for _, source := range sources {
+		semanticdbDirs = append(semanticdbDirs, checkFixture(t, source))
+	}
+
+	var buf bytes.Buffer
+	indexer := NewIndexer(semanticdbDirs, protocol.ToolInfo{Name: "lsif-semanticdb"}, &buf, Options{SourceRoot: dir})
+	stats, err := indexer.Index()
+	if err != nil {
+		t.Fatalf("index: %v", err)
+	}
+
+	got := normalizeDump(t, &buf, indexer.UnresolvedReferences())
+	if stats.NumRejectedOccurrences > 0 {
+		got += fmt.Sprintf("rejected %d\n", stats.NumRejectedOccurrences)
+	}
+
+	golden := filepath.Join(dir, "dump.golden")
+	if *update {
+		if err := ioutil.WriteFile(golden, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("dump differs from %s (run go test -update to accept):\n%s", golden, lineDiff(string(want), got))
+	}
+}
+
+// checkFixture checks that the checked-in binary SemanticDB file of a
+// textproto fixture decodes to the documents of the fixture, or rewrites it
+// with -update, and returns the directory holding its META-INF directory.
+func checkFixture(t *testing.T, source string) string {
+	want, err := semanticdb.ReadFile(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(source), semanticdb.TextprotoExtension)
+	dir := filepath.Join(filepath.Dir(source), name)
+	path := filepath.Join(dir, "META-INF", "semanticdb", name+semanticdb.Extension)
+
+	if *update {
+		contents, err := proto.Marshal(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return dir
+	}
+
+	got, err := semanticdb.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if !proto.Equal(got, want) {
+		t.Fatalf("%s is out of date with %s (run go test -update)", path, source)
+	}
+
+	return dir
+}
+
+// normalizeDump returns the normalized navigation data of the dump followed
+// by the unresolved references.
+func normalizeDump(t *testing.T, dump *bytes.Buffer, unresolved []*UnresolvedReference) string {
+	elements, err := lsif.Read(dump)
+	if err != nil {
+		t.Fatalf("read dump: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := lsif.Normalize(&out, elements); err != nil {
+		t.Fatalf("normalize dump: %v", err)
+	}
+
+	for _, reference := range unresolved {
+		fmt.Fprintf(&out, "unresolved %s %s %d\n", reference.Symbol, reference.Kind, reference.Occurrences)
+	}
+
+	return out.String()
+}
+
+// lineDiff lists the lines that differ between two texts, prefixed with - for
+// lines of want and + for lines of got.
+func lineDiff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	var b strings.Builder
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			fmt.Fprintf(&b, "line %d:\n- %s\n+ %s\n", i+1, w, g)
+		}
+	}
+
+	return b.String()
+}
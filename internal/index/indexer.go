@@ -1,26 +1,29 @@
 package index
 
 import (
+	"encoding/base64"
 	"fmt"
 	"io"
-	"io/ioutil"
-	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/sourcegraph/lsif-semanticdb/internal/log"
 	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
 	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
-	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol/writer"
-	"google.golang.org/protobuf/proto"
 )
 
-const LanguageScala = "scala"
+const (
+	LanguageScala = "scala"
+	LanguageJava  = "java"
+)
 
 // Indexer reads SemanticDB files and outputs LSIF data.
 type Indexer interface {
 	Index() (*Stats, error)
+	Reindex(w io.Writer, paths []string) (*Stats, error)
+	UnresolvedReferences() []*UnresolvedReference
 }
 
 // Stats contains statistics of data processed during index.
@@ -28,45 +31,114 @@ type Stats struct {
 	NumFiles    uint
 	NumDefs     uint
 	NumElements uint64
+
+	// Occurrences dropped because of a missing, invalid or out-of-bounds range
+	NumRejectedOccurrences uint
 }
 
 // indexer keeps track of all information needed to generate an LSIF dump.
 type indexer struct {
-	projectRoot       []string
-	printProgressDots bool
-	toolInfo          protocol.ToolInfo
-	w                 *writer.Emitter
+	projectRoot []string
+	sourceRoot  string
+	language    string
+	encoding    string
+	contents    bool
+	progress    Progress
+	logger      log.Logger
+	toolInfo    protocol.ToolInfo
+	w           *emitter
+
+	// Loaded SemanticDB files, including documents skipped as duplicates
+	databases map[string][]*pb.TextDocument // Keys: SemanticDB file path
+
+	// Documents and symbols to index, compiled when loading
+	filterOptions Filter
+	filter        *filter
 
 	// Type correlation
 	files map[string]*fileInfo      // Keys: document uri
 	defs  map[string]*defInfo       // Keys: symbol key
 	refs  map[string]*refResultInfo // Keys: symbol key
 
+	// Resolution of references that do not name their definition
+	resolver *semanticdb.Resolver
+
+	// Definitions overriding a symbol
+	impls map[string]map[uint64][]uint64 // Keys: overridden symbol key, document id
+
+	// Unresolved references
+	unresolved map[string]*UnresolvedReference // Keys: symbol key
+
 	// Monikers
 	packageName           string
 	packageVersion        string
-	packageInformationIDs map[string]string
+	packages              map[string]string // Keys: symbol key defined by another package
+	packageResolver       *semanticdb.Resolver
+	packageInformationIDs map[string]uint64 // Keys: package name
 }
 
-// NewIndexer creates a new Indexer.
+// NewIndexer creates a new Indexer reading the SemanticDB files in the
+// directories given as projectRoot and writing the dump to w.
 func NewIndexer(
 	projectRoot []string,
-	printProgressDots bool,
 	toolInfo protocol.ToolInfo,
 	w io.Writer,
+	opts Options,
 ) Indexer {
-	return &indexer{
-		projectRoot:       projectRoot,
-		printProgressDots: printProgressDots,
-		toolInfo:          toolInfo,
-		w:                 writer.NewEmitter(NewJSONWriter(w)),
+	i := &indexer{
+		projectRoot:   projectRoot,
+		sourceRoot:    opts.SourceRoot,
+		language:      opts.Language,
+		encoding:      opts.PositionEncoding,
+		contents:      opts.Contents,
+		filterOptions: opts.Filter,
+		progress:      opts.Progress,
+		logger:        opts.Logger,
+		toolInfo:      toolInfo,
 
 		// Empty maps
+		databases:             map[string][]*pb.TextDocument{},
 		files:                 map[string]*fileInfo{},
 		defs:                  map[string]*defInfo{},
 		refs:                  map[string]*refResultInfo{},
-		packageInformationIDs: map[string]string{},
+		impls:                 map[string]map[uint64][]uint64{},
+		unresolved:            map[string]*UnresolvedReference{},
+		packages:              map[string]string{},
+		packageInformationIDs: map[string]uint64{},
+	}
+
+	i.setOutput(w)
+
+	if i.sourceRoot == "" {
+		i.sourceRoot = "."
+	}
+	if i.language == "" {
+		i.language = LanguageScala
 	}
+	if i.encoding == "" {
+		i.encoding = PositionEncodingUTF16
+	}
+	if i.logger == nil {
+		i.logger = log.Nop
+	}
+
+	if monikers := opts.Monikers; monikers != nil {
+		i.packageName = monikers.PackageName
+		i.packageVersion = monikers.PackageVersion
+		i.packageResolver = monikers.Resolver
+		for symbol, packageName := range monikers.Packages {
+			if packageName != monikers.PackageName {
+				i.packages[symbol] = packageName
+			}
+		}
+	}
+
+	return i
+}
+
+// setOutput directs the elements emitted from now on to w.
+func (i *indexer) setOutput(w io.Writer) {
+	i.w = newEmitter(NewJSONWriter(w))
 }
 
 // Index generates an LSIF dump from a SemanticDB dump by processing each
@@ -83,93 +155,133 @@ func (i *indexer) Index() (*Stats, error) {
 }
 
 func (i *indexer) loadDatabases() error {
-	log.Infoln("Loading semanticdb data...")
-	for _, projectRoot := range i.projectRoot {
-		err := filepath.Walk(projectRoot, func(path string, info os.FileInfo, err error) error {
-			if err != nil {
-				return err
-			}
+	filter, err := compileFilter(i.filterOptions)
+	if err != nil {
+		return fmt.Errorf("filter: %v", err)
+	}
+	i.filter = filter
 
-			if !info.IsDir() && strings.HasSuffix(path, ".semanticdb") {
-				if err := i.loadDatabase(path); err != nil {
-					return fmt.Errorf("load database %s: %v", path, err)
-				}
-			}
+	if err := checkPositionEncoding(i.encoding); err != nil {
+		return err
+	}
 
-			return nil
-		})
-		if err != nil {
-			return fmt.Errorf("load databases: %v", err)
+	i.logger.Info("Loading SemanticDB files", log.F("dirs", strings.Join(i.projectRoot, ",")))
+	progress := i.startPhase(PhaseLoading, 0)
+	defer progress.finish()
+
+	return semanticdb.WalkSelected(i.projectRoot, i.filter.file, func(path string, textDocuments *pb.TextDocuments) error {
+		progress.step()
+		return i.loadDatabase(path, textDocuments)
+	})
+}
+
+func (i *indexer) loadDatabase(path string, textDocuments *pb.TextDocuments) error {
+	for _, document := range textDocuments.GetDocuments() {
+		if document.GetUri() == "" {
+			i.logger.Warn("Skipping document without uri", log.F("path", path))
+			continue
+		}
+		if !i.filter.document(document.GetUri()) {
+			i.logger.Debug("Excluded document", log.F("uri", document.GetUri()))
+			continue
+		}
+		i.databases[path] = append(i.databases[path], document)
+
+		if fi, ok := i.files[document.GetUri()]; ok {
+			i.logger.Warn("Skipping duplicate document", log.F("path", path), log.F("uri", document.GetUri()), log.F("first", fi.path))
+			continue
+		}
+		if err := i.loadDocument(path, document); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-func (i *indexer) loadDatabase(path string) error {
-	contents, err := ioutil.ReadFile(path)
-	if err != nil {
-		return err
+// loadDocument prepares a single document of a SemanticDB file for indexing.
+func (i *indexer) loadDocument(path string, document *pb.TextDocument) error {
+	text := i.documentText(document)
+	if text == "" && i.encoding != PositionEncodingUTF16 && len(document.GetOccurrences()) > 0 {
+		// Its ranges would be emitted as UTF-16 in a dump declaring another encoding
+		i.logger.Warn("Skipping document without text whose positions cannot be converted", log.F("uri", document.GetUri()), log.F("encoding", i.encoding))
+		return nil
 	}
 
-	textDocuments := &pb.TextDocuments{}
-	if err := proto.Unmarshal(contents, textDocuments); err != nil {
-		return err
+	problems, numClamped := sanitizeDocument(document, text)
+	numRejected := 0
+	for problem, count := range problems {
+		i.logger.Warn("Dropping invalid occurrences", log.F("uri", document.GetUri()), log.F("problem", problem), log.F("count", count))
+		numRejected += count
+	}
+	if numClamped > 0 {
+		i.logger.Warn("Clamping ranges beyond the text", log.F("uri", document.GetUri()), log.F("count", numClamped))
 	}
+	i.filter.filterSymbols(document)
 
-	for _, document := range textDocuments.GetDocuments() {
-		symbols := map[string]*pb.SymbolInformation{}
-		for _, symbol := range document.GetSymbols() {
-			key := symbol.GetSymbol()
-			if _, ok := symbols[key]; ok {
-				return fmt.Errorf("duplicate symbol: %s", key)
-			}
-			symbols[key] = symbol
+	symbols := map[string]*pb.SymbolInformation{}
+	for _, symbol := range document.GetSymbols() {
+		key := symbol.GetSymbol()
+		if _, ok := symbols[key]; ok {
+			return fmt.Errorf("duplicate symbol: %s", key)
 		}
+		symbols[key] = symbol
+	}
 
-		i.files[document.GetUri()] = &fileInfo{
-			document:  document,
-			symbols:   symbols,
-			localDefs: map[string]*defInfo{},
-			localRefs: map[string]*refResultInfo{},
-		}
+	i.files[document.GetUri()] = &fileInfo{
+		path:      path,
+		document:  document,
+		dialect:   semanticdb.DialectOf(document),
+		positions: newPositionConverter(i.encoding, text),
+		symbols:   symbols,
+		ranges:    map[span]*rangeInfo{},
+		localDefs: map[string]*defInfo{},
+		localRefs: map[string]*refResultInfo{},
+
+		numRejected: numRejected,
 	}
 
 	return nil
 }
 
 func (i *indexer) index() (*Stats, error) {
-	realURI, err := filepath.Abs(".")
+	realURI, err := filepath.Abs(i.sourceRoot)
 	if err != nil {
 		return nil, fmt.Errorf("get abspath of project root: %v", err)
 	}
 
-	_ = i.w.EmitMetaData("file://"+realURI, i.toolInfo)
-	proID := i.w.EmitProject(LanguageScala)
+	i.resolver = semanticdb.NewResolver()
+	for _, fi := range i.files {
+		i.resolver.AddDocument(fi.document)
+	}
+
+	_ = i.w.EmitMetaData("file://"+realURI, i.toolInfo, lsifPositionEncoding(i.encoding))
+	proID := i.w.EmitProject(i.language)
 	_ = i.indexDbDocs(proID)
 
+	progress := i.startPhase(PhaseDefinitions, len(i.files))
 	for uri, fi := range i.files {
-		if i.printProgressDots {
-			fmt.Fprintf(os.Stdout, ".")
-		}
+		progress.step()
 
 		_ = i.indexDbDefs(uri, fi, proID)
 	}
+	progress.finish()
 
+	progress = i.startPhase(PhaseUses, len(i.files))
 	for uri, fi := range i.files {
-		if i.printProgressDots {
-			fmt.Fprintf(os.Stdout, ".")
-		}
+		progress.step()
 
 		_ = i.indexDbUses(uri, fi, proID)
 	}
+	progress.finish()
 
-	log.Infoln("Linking references...")
+	i.logger.Info("Linking references")
 
+	progress = i.startPhase(PhaseLinking, len(i.files))
+	linked := map[*refResultInfo]bool{}
+	implementations := map[string]bool{}
 	for _, fi := range i.files {
-		if i.printProgressDots {
-			fmt.Fprintf(os.Stdout, ".")
-		}
+		progress.step()
 
 		for _, occurrence := range fi.document.GetOccurrences() {
 			if occurrence.GetRole() != pb.SymbolOccurrence_DEFINITION {
@@ -177,7 +289,7 @@ func (i *indexer) index() (*Stats, error) {
 			}
 
 			key := occurrence.GetSymbol()
-			isLocal := strings.HasPrefix(key, "local")
+			isLocal := semanticdb.IsLocal(key)
 
 			var refResultInfo *refResultInfo
 			if isLocal {
@@ -186,9 +298,10 @@ func (i *indexer) index() (*Stats, error) {
 				refResultInfo = i.refs[key]
 			}
 
-			if refResultInfo == nil {
+			if refResultInfo == nil || linked[refResultInfo] {
 				continue
 			}
+			linked[refResultInfo] = true
 
 			refResultID := i.w.EmitReferenceResult()
 			_ = i.w.EmitTextDocumentReferences(refResultInfo.resultSetID, refResultID)
@@ -200,29 +313,30 @@ func (i *indexer) index() (*Stats, error) {
 			for docID, rangeIDs := range refResultInfo.refRangeIDs {
 				_ = i.w.EmitItemOfReferences(refResultID, rangeIDs, docID)
 			}
-		}
 
-		if len(fi.defRangeIDs) > 0 || len(fi.useRangeIDs) > 0 {
-			// Deduplicate ranges before emitting a contains edge
-			union := map[uint64]bool{}
-			for _, id := range fi.defRangeIDs {
-				union[id] = true
-			}
-			for _, id := range fi.useRangeIDs {
-				union[id] = true
-			}
-			allRanges := []uint64{}
-			for id := range union {
-				allRanges = append(allRanges, id)
+			if _, ok := i.impls[key]; ok && !isLocal && !implementations[key] {
+				i.emitImplementations(refResultInfo.resultSetID, i.impls[key])
+				implementations[key] = true
 			}
+		}
 
-			_ = i.w.EmitContains(fi.docID, allRanges)
+		i.emitSharedResults(fi)
+
+		if len(fi.rangeInfos) > 0 {
+			rangeIDs := make([]uint64, 0, len(fi.rangeInfos))
+			for _, r := range fi.rangeInfos {
+				rangeIDs = append(rangeIDs, r.id)
+			}
+			_ = i.w.EmitContains(fi.docID, rangeIDs)
 		}
 	}
+	progress.finish()
 
 	numDefs := len(i.defs)
+	numRejected := 0
 	for _, fi := range i.files {
 		numDefs += len(fi.localDefs)
+		numRejected += fi.numRejected
 	}
 
 	if err := i.w.Flush(); err != nil {
@@ -233,46 +347,48 @@ func (i *indexer) index() (*Stats, error) {
 		NumFiles:    uint(len(i.files)),
 		NumDefs:     uint(numDefs),
 		NumElements: i.w.NumElements(),
+
+		NumRejectedOccurrences: uint(numRejected),
 	}, nil
 }
 
 func (i *indexer) indexDbDocs(proID uint64) error {
-	log.Infoln("Emitting documents...")
+	i.logger.Info("Emitting documents")
 
+	progress := i.startPhase(PhaseDocuments, len(i.files))
 	for uri, fi := range i.files {
-		if i.printProgressDots {
-			fmt.Fprintf(os.Stdout, ".")
-		}
+		progress.step()
 
-		realURI, err := filepath.Abs(uri)
+		realURI, err := filepath.Abs(filepath.Join(i.sourceRoot, uri))
 		if err != nil {
 			return fmt.Errorf("get abspath of document uri: %v", err)
 		}
 
-		docID := i.w.EmitDocument(LanguageScala, realURI)
+		var contents string
+		if i.contents {
+			contents = base64.StdEncoding.EncodeToString([]byte(i.documentText(fi.document)))
+		}
+		docID := i.w.EmitDocument(documentLanguage(fi.document), realURI, contents)
 		_ = i.w.EmitContains(proID, []uint64{docID})
 		fi.docID = docID
 	}
+	progress.finish()
 
 	return nil
 }
 
 func (i *indexer) indexDbDefs(uri string, fi *fileInfo, proID uint64) (err error) {
-	log.Infoln("Emitting definitions for", uri)
+	i.logger.Debug("Emitting definitions", log.F("uri", uri))
 
-	var rangeIDs []uint64
 	for _, occurrence := range fi.document.GetOccurrences() {
 		if occurrence.GetRole() != pb.SymbolOccurrence_DEFINITION {
 			continue
 		}
 
 		key := occurrence.GetSymbol()
-		isLocal := strings.HasPrefix(key, "local")
+		isLocal := semanticdb.IsLocal(key)
 		symbol := fi.symbols[key]
 
-		rangeID := i.w.EmitRange(convertRange(occurrence.GetRange()))
-		rangeIDs = append(rangeIDs, rangeID)
-
 		var m map[string]*refResultInfo
 		if isLocal {
 			m = fi.localRefs
@@ -291,23 +407,41 @@ func (i *indexer) indexDbDefs(uri string, fi *fileInfo, proID uint64) (err error
 			}
 
 			m[key] = refResult
+
+			if !isLocal {
+				i.emitExportMoniker(resultSetID, key)
+			}
 		}
 
+		r := i.emitRange(fi, occurrence.GetRange())
+		if !i.linkRange(r, refResult.resultSetID) && r.hasResultSet(refResult.resultSetID) {
+			// The same definition was reported twice
+			continue
+		}
+		rangeID := r.id
+
 		if _, ok := refResult.defRangeIDs[fi.docID]; !ok {
 			refResult.defRangeIDs[fi.docID] = []uint64{}
 		}
 		refResult.defRangeIDs[fi.docID] = append(refResult.defRangeIDs[fi.docID], rangeID)
 
-		_ = i.w.EmitNext(rangeID, refResult.resultSetID)
-		defResultID := i.w.EmitDefinitionResult()
-		_ = i.w.EmitTextDocumentDefinition(refResult.resultSetID, defResultID)
-		_ = i.w.EmitItem(defResultID, []uint64{rangeID}, fi.docID)
+		if refResult.defResultID == 0 {
+			// Later definitions of the symbol are items of the same result
+			refResult.defResultID = i.w.EmitDefinitionResult()
+			_ = i.w.EmitTextDocumentDefinition(refResult.resultSetID, refResult.defResultID)
+
+			refResult.hover = i.hoverContents(fi, symbol)
+			hoverResultID := i.w.EmitHoverResult(refResult.hover)
+			_ = i.w.EmitTextDocumentHover(refResult.resultSetID, hoverResultID)
+		}
+		_ = i.w.EmitItem(refResult.defResultID, []uint64{rangeID}, fi.docID)
 
 		def := &defInfo{
 			docID:       fi.docID,
 			rangeID:     rangeID,
 			resultSetID: refResult.resultSetID,
-			defResultID: defResultID,
+			defResultID: refResult.defResultID,
+			refResult:   refResult,
 		}
 
 		if isLocal {
@@ -315,33 +449,45 @@ func (i *indexer) indexDbDefs(uri string, fi *fileInfo, proID uint64) (err error
 		} else {
 			i.defs[key] = def
 		}
+		r.defs = append(r.defs, def)
 
-		var language string
-
-		if fi.document.GetLanguage() != pb.Language_UNKNOWN_LANGUAGE {
-			language = strings.ToLower(fi.document.GetLanguage().String())
+		for _, overridden := range symbol.GetOverriddenSymbols() {
+			if _, ok := i.impls[overridden]; !ok {
+				i.impls[overridden] = map[uint64][]uint64{}
+			}
+			i.impls[overridden][fi.docID] = append(i.impls[overridden][fi.docID], rangeID)
 		}
+	}
 
-		contents := []protocol.MarkedString{
-			{
-				Language: language,
-				Value:    symbol.GetDisplayName(),
-			},
-		}
+	return nil
+}
+
+// hoverContents returns the contents of the hover result of a symbol defined
+// in a document.
+func (i *indexer) hoverContents(fi *fileInfo, symbol *pb.SymbolInformation) []protocol.MarkedString {
+	var language string
 
-		hoverResultID := i.w.EmitHoverResult(contents)
-		_ = i.w.EmitTextDocumentHover(refResult.resultSetID, hoverResultID)
-		rangeIDs = append(rangeIDs, rangeID)
+	if fi.document.GetLanguage() != pb.Language_UNKNOWN_LANGUAGE {
+		language = strings.ToLower(fi.document.GetLanguage().String())
 	}
 
-	fi.defRangeIDs = append(fi.defRangeIDs, rangeIDs...)
-	return nil
+	contents := []protocol.MarkedString{
+		{
+			Language: language,
+			Value:    semanticdb.HoverText(symbol, fi.dialect, lookupSymbol(fi)),
+		},
+	}
+
+	if documentation := semanticdb.DocumentationMarkdown(symbol.GetDocumentation()); documentation != "" {
+		contents = append(contents, protocol.RawMarkedString(documentation))
+	}
+
+	return contents
 }
 
 func (i *indexer) indexDbUses(uri string, fi *fileInfo, proID uint64) (err error) {
-	log.Infoln("Emitting uses for", uri)
+	i.logger.Debug("Emitting uses", log.F("uri", uri))
 
-	var rangeIDs []uint64
 	for _, occurrence := range fi.document.GetOccurrences() {
 		if occurrence.GetRole() != pb.SymbolOccurrence_REFERENCE {
 			continue
@@ -349,46 +495,56 @@ func (i *indexer) indexDbUses(uri string, fi *fileInfo, proID uint64) (err error
 
 		def, refResult := i.getDefAndRefInfo(fi, occurrence.GetSymbol())
 
-		rangeID := i.w.EmitRange(convertRange(occurrence.GetRange()))
-		rangeIDs = append(rangeIDs, rangeID)
+		r := i.emitRange(fi, occurrence.GetRange())
 
 		if def == nil {
-			refResultID := i.w.EmitReferenceResult()
-			_ = i.w.EmitTextDocumentReferences(rangeID, refResultID)
-			_ = i.w.EmitItemOfReferences(refResultID, []uint64{rangeID}, fi.docID)
+			// The reference result of the range is emitted when linking
+			// references, once all occurrences sharing it are known
+			r.unresolved = true
+			if !i.emitImportMoniker(r, fi, occurrence.GetSymbol()) {
+				i.recordUnresolved(uri, occurrence)
+			}
 			continue
 		}
 
-		_ = i.w.EmitNext(rangeID, def.resultSetID)
+		if !i.linkRange(r, def.resultSetID) && r.hasResultSet(def.resultSetID) {
+			// The range is already a definition or reference of the symbol
+			continue
+		}
+		r.defs = append(r.defs, def)
 
 		if refResult != nil {
 			if _, ok := refResult.refRangeIDs[fi.docID]; !ok {
 				refResult.refRangeIDs[fi.docID] = []uint64{}
 			}
-			refResult.refRangeIDs[fi.docID] = append(refResult.refRangeIDs[fi.docID], rangeID)
+			refResult.refRangeIDs[fi.docID] = append(refResult.refRangeIDs[fi.docID], r.id)
 		}
 	}
 
-	fi.useRangeIDs = append(fi.useRangeIDs, rangeIDs...)
 	return nil
 }
 
-func (i *indexer) getDefAndRefInfo(fi *fileInfo, symbol string) (*defInfo, *refResultInfo) {
-	def, ok := fi.localDefs[symbol]
-	if ok {
-		return def, fi.localRefs[symbol]
-	}
+// emitImplementations attaches the definitions of the symbols overriding a
+// symbol to its result set as implementations. The emitter of the protocol
+// library has no implementation results, so reference results and edges are
+// emitted and relabeled.
+func (i *indexer) emitImplementations(resultSetID uint64, rangeIDs map[uint64][]uint64) {
+	implResultID := i.w.EmitImplementationResult()
+	_ = i.w.EmitTextDocumentImplementation(resultSetID, implResultID)
 
-	keys := []string{symbol}
-	keys = append(keys, strings.Replace(symbol, ".", "#", -1))                               // pattern matching case class
-	keys = append(keys, strings.Replace(strings.Replace(symbol, "_=", "", -1), "`", "", -1)) // field assignment
+	for docID, ids := range rangeIDs {
+		_ = i.w.EmitItem(implResultID, ids, docID)
+	}
+}
 
-	for _, k := range keys {
-		def, ok := i.defs[k]
-		if ok {
-			return def, i.refs[k]
-		}
+func (i *indexer) getDefAndRefInfo(fi *fileInfo, symbol string) (*defInfo, *refResultInfo) {
+	k, isLocal, ok := i.resolver.Definition(fi.document.GetUri(), fi.dialect, symbol)
+	if !ok {
+		return nil, nil
 	}
 
-	return nil, nil
+	if isLocal {
+		return fi.localDefs[k], fi.localRefs[k]
+	}
+	return i.defs[k], i.refs[k]
 }
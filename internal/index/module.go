@@ -0,0 +1,185 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+// Module is a set of SemanticDB directories indexed as a single LSIF project.
+type Module struct {
+	Name string
+	Dirs []string
+}
+
+// buildDirs are the names of the output directories of sbt and Gradle.
+var buildDirs = map[string]bool{"target": true, "build": true}
+
+// InferModules groups the SemanticDB files found in the given directories by
+// the sbt or Gradle module that produced them. A module is named after the
+// path of its target or build directory relative to the working directory.
+// Files outside of such a directory belong to the module named after the
+// directory holding their META-INF directory, or after the directory they
+// were found in if they are not below a META-INF directory.
+func InferModules(dirs []string) ([]Module, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	roots := map[string]map[string]bool{} // Keys: module name, module directory
+	for _, dir := range dirs {
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || !semanticdb.IsFile(path) {
+				return nil
+			}
+
+			name, root := inferModule(wd, dir, path)
+			if _, ok := roots[name]; !ok {
+				roots[name] = map[string]bool{}
+			}
+			roots[name][root] = true
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("infer modules: %v", err)
+		}
+	}
+
+	var modules []Module
+	for name, dirs := range roots {
+		module := Module{Name: name}
+		for dir := range dirs {
+			module.Dirs = append(module.Dirs, dir)
+		}
+		sort.Strings(module.Dirs)
+		modules = append(modules, module)
+	}
+
+	sort.Slice(modules, func(i, j int) bool { return modules[i].Name < modules[j].Name })
+	return modules, nil
+}
+
+// inferModule returns the module name and directory of a SemanticDB file
+// found in dir.
+func inferModule(wd, dir, path string) (name, root string) {
+	// Source paths below META-INF may contain directories named build
+	prefix := path
+	if i := strings.Index(path, string(filepath.Separator)+"META-INF"+string(filepath.Separator)); i >= 0 {
+		prefix = path[:i]
+	}
+
+	root = dir
+	moduleDir := dir
+	if prefix != path {
+		// Without a build directory, the module is the directory holding
+		// META-INF so that sibling modules are not merged
+		if strings.HasPrefix(prefix, dir) {
+			root = prefix
+		}
+		moduleDir = prefix
+	}
+
+	components := strings.Split(prefix, string(filepath.Separator))
+	for i := len(components) - 1; i > 0; i-- {
+		if !buildDirs[components[i]] {
+			continue
+		}
+
+		buildDir := strings.Join(components[:i+1], string(filepath.Separator))
+		if strings.HasPrefix(buildDir, dir) {
+			root = buildDir
+		}
+		moduleDir = strings.Join(components[:i], string(filepath.Separator))
+		break
+	}
+
+	name, err := filepath.Rel(wd, moduleDir)
+	if err != nil || name == "." || strings.HasPrefix(name, "..") {
+		name = filepath.Base(moduleDir)
+	}
+
+	return filepath.ToSlash(name), root
+}
+
+// ReadModuleMap reads a JSON file mapping module names to the SemanticDB
+// directories of the module.
+func ReadModuleMap(path string) ([]Module, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var moduleMap map[string][]string
+	if err := json.Unmarshal(contents, &moduleMap); err != nil {
+		return nil, fmt.Errorf("parse module map: %v", err)
+	}
+
+	var modules []Module
+	for name, dirs := range moduleMap {
+		module := Module{Name: name}
+		for _, dir := range dirs {
+			dir, err := filepath.Abs(dir)
+			if err != nil {
+				return nil, fmt.Errorf("get abspath of SemanticDB dir: %v", err)
+			}
+			module.Dirs = append(module.Dirs, dir)
+		}
+		modules = append(modules, module)
+	}
+
+	sort.Slice(modules, func(i, j int) bool { return modules[i].Name < modules[j].Name })
+	return modules, nil
+}
+
+// ModulePackages returns the global symbols defined by each module mapped to
+// the name of the module, along with a resolver that knows the documents of
+// all modules. Symbols defined by several modules belong to the first one.
+// Documents and symbols not selected by the filter are skipped as they are
+// when indexing.
+func ModulePackages(modules []Module, f Filter) (map[string]string, *semanticdb.Resolver, error) {
+	filter, err := compileFilter(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("filter: %v", err)
+	}
+
+	packages := map[string]string{}
+	resolver := semanticdb.NewResolver()
+	for _, module := range modules {
+		err := semanticdb.WalkSelected(module.Dirs, filter.file, func(path string, textDocuments *pb.TextDocuments) error {
+			for _, document := range textDocuments.GetDocuments() {
+				if !filter.document(document.GetUri()) {
+					continue
+				}
+				filter.filterSymbols(document)
+				resolver.AddDocument(document)
+
+				for _, occurrence := range document.GetOccurrences() {
+					key := occurrence.GetSymbol()
+					if occurrence.GetRole() != pb.SymbolOccurrence_DEFINITION || semanticdb.IsLocal(key) {
+						continue
+					}
+					if _, ok := packages[key]; !ok {
+						packages[key] = module.Name
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return packages, resolver, nil
+}
@@ -0,0 +1,227 @@
+package index
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+	"google.golang.org/protobuf/proto"
+)
+
+// writeDocuments writes a SemanticDB file containing the documents to the
+// path below dir, creating its parent directories.
+func writeDocuments(t *testing.T, dir, path string, documents ...*pb.TextDocument) {
+	t.Helper()
+
+	contents, err := proto.Marshal(&pb.TextDocuments{Documents: documents})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path = filepath.Join(dir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// chdir changes the working directory to a new temporary directory and
+// returns it along with a function restoring the previous one.
+func chdir(t *testing.T) (string, func()) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The working directory is reported with symlinks resolved
+	if dir, err = filepath.EvalSymlinks(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	return dir, func() {
+		_ = os.Chdir(wd)
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestInferModules(t *testing.T) {
+	dir, cleanup := chdir(t)
+	defer cleanup()
+
+	for _, path := range []string{
+		"modules/core/target/scala-2.13/meta/META-INF/semanticdb/src/A.scala.semanticdb",
+		"modules/core/target/scala-2.13/test-meta/META-INF/semanticdb/src/ATest.scala.semanticdb",
+		// Directories named build below META-INF are source directories
+		"app/build/classes/java/main/META-INF/semanticdb/src/build/B.java.semanticdb",
+		"other/META-INF/semanticdb/C.scala.semanticdb",
+	} {
+		writeDocuments(t, dir, path)
+	}
+	// Other files are ignored
+	if err := ioutil.WriteFile(filepath.Join(dir, "app", "build", "README"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	modules, err := InferModules([]string{filepath.Join(dir, "modules"), filepath.Join(dir, "app"), filepath.Join(dir, "other")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Module{
+		{Name: "app", Dirs: []string{filepath.Join(dir, "app", "build")}},
+		{Name: "modules/core", Dirs: []string{filepath.Join(dir, "modules", "core", "target")}},
+		{Name: "other", Dirs: []string{filepath.Join(dir, "other")}},
+	}
+	if !reflect.DeepEqual(modules, want) {
+		t.Errorf("InferModules() = %v, want %v", modules, want)
+	}
+
+	// A directory inside of the build directory is kept as the module
+	// directory
+	meta := filepath.Join(dir, "modules", "core", "target", "scala-2.13", "meta")
+	modules, err = InferModules([]string{meta})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Module{{Name: "modules/core", Dirs: []string{meta}}}; !reflect.DeepEqual(modules, want) {
+		t.Errorf("InferModules() = %v, want %v", modules, want)
+	}
+
+	if _, err := InferModules([]string{filepath.Join(dir, "missing")}); err == nil {
+		t.Errorf("expected an error for a missing directory")
+	}
+}
+
+func TestInferModulesWithoutBuildDirectory(t *testing.T) {
+	dir, cleanup := chdir(t)
+	defer cleanup()
+
+	for _, path := range []string{
+		"modules/core/META-INF/semanticdb/src/A.scala.semanticdb",
+		"modules/app/META-INF/semanticdb/src/B.scala.semanticdb",
+		"modules/app/META-INF/semanticdb/src/C.scala.semanticdb",
+	} {
+		writeDocuments(t, dir, path)
+	}
+
+	modules, err := InferModules([]string{filepath.Join(dir, "modules")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each module only holds its own documents
+	want := []Module{
+		{Name: "modules/app", Dirs: []string{filepath.Join(dir, "modules", "app")}},
+		{Name: "modules/core", Dirs: []string{filepath.Join(dir, "modules", "core")}},
+	}
+	if !reflect.DeepEqual(modules, want) {
+		t.Errorf("InferModules() = %v, want %v", modules, want)
+	}
+}
+
+func TestReadModuleMap(t *testing.T) {
+	dir, cleanup := chdir(t)
+	defer cleanup()
+
+	path := filepath.Join(dir, "modules.json")
+	if err := ioutil.WriteFile(path, []byte(`{"core": ["core/target"], "app": ["app/a", "/b"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	modules, err := ReadModuleMap(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Module{
+		{Name: "app", Dirs: []string{filepath.Join(dir, "app", "a"), "/b"}},
+		{Name: "core", Dirs: []string{filepath.Join(dir, "core", "target")}},
+	}
+	if !reflect.DeepEqual(modules, want) {
+		t.Errorf("ReadModuleMap() = %v, want %v", modules, want)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(`["core"]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadModuleMap(path); err == nil {
+		t.Errorf("expected an error for an invalid module map")
+	}
+
+	if _, err := ReadModuleMap(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("expected an error for a missing module map")
+	}
+}
+
+func TestModulePackages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	definition := func(symbol string) *pb.SymbolOccurrence {
+		return &pb.SymbolOccurrence{Range: &pb.Range{}, Symbol: symbol, Role: pb.SymbolOccurrence_DEFINITION}
+	}
+
+	writeDocuments(t, dir, "core/Box.java.semanticdb", &pb.TextDocument{
+		Uri:      "core/Box.java",
+		Language: pb.Language_JAVA,
+		Symbols: []*pb.SymbolInformation{
+			{Symbol: "j/Box#<init>().", Kind: pb.SymbolInformation_CONSTRUCTOR},
+		},
+		Occurrences: []*pb.SymbolOccurrence{definition("j/Box#"), definition("j/Box#<init>()."), definition("local0")},
+	})
+	writeDocuments(t, dir, "app/App.java.semanticdb", &pb.TextDocument{
+		Uri:         "app/App.java",
+		Language:    pb.Language_JAVA,
+		Occurrences: []*pb.SymbolOccurrence{definition("j/App#"), definition("j/Box#")},
+	})
+
+	modules := []Module{
+		{Name: "core", Dirs: []string{filepath.Join(dir, "core")}},
+		{Name: "app", Dirs: []string{filepath.Join(dir, "app")}},
+	}
+	packages, resolver, err := ModulePackages(modules, Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{"j/Box#": "core", "j/Box#<init>().": "core", "j/App#": "app"}
+	if !reflect.DeepEqual(packages, want) {
+		t.Errorf("ModulePackages() = %v, want %v", packages, want)
+	}
+
+	// Instantiations in other modules resolve to the class of the constructor
+	key, ok := resolver.Resolve("j/Box#<init>().", semanticdb.Java, func(symbol string) bool {
+		_, ok := packages[symbol]
+		return ok
+	})
+	if key != "j/Box#" || !ok {
+		t.Errorf("Resolve() = %q, %v, want %q, true", key, ok, "j/Box#")
+	}
+
+	// Excluded documents and symbols define nothing
+	packages, _, err = ModulePackages(modules, Filter{ExcludeDocuments: []string{"app/**"}, ExcludeSymbols: []string{"j/Box#<init>"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want = map[string]string{"j/Box#": "core"}
+	if !reflect.DeepEqual(packages, want) {
+		t.Errorf("ModulePackages() with filter = %v, want %v", packages, want)
+	}
+}
@@ -0,0 +1,84 @@
+package index
+
+import "github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+
+// MonikerScheme is the scheme of monikers identifying SemanticDB symbols.
+const MonikerScheme = "semanticdb"
+
+// Monikers configures the monikers that link symbols across LSIF projects.
+type Monikers struct {
+	// PackageName and PackageVersion identify the package of the project
+	PackageName    string
+	PackageVersion string
+
+	// Packages maps global symbols defined outside of the project to the
+	// name of the package defining them
+	Packages map[string]string
+
+	// Resolver resolves references to the symbols of Packages. It knows the
+	// documents of all packages, e.g. the export forwarders and constructors
+	// of other modules. If nil, references are resolved with the documents of
+	// the project only.
+	Resolver *semanticdb.Resolver
+}
+
+// emitExportMoniker attaches an export moniker to the result set of a global
+// symbol defined by the project.
+func (i *indexer) emitExportMoniker(resultSetID uint64, symbol string) {
+	if i.packageName == "" {
+		return
+	}
+
+	i.emitMoniker("export", resultSetID, symbol, i.packageName)
+}
+
+// emitImportMoniker attaches an import moniker to the range of a reference to
+// a symbol defined by another package, unless another occurrence of the range
+// already did. It returns false if no other package defines the symbol.
+func (i *indexer) emitImportMoniker(r *rangeInfo, fi *fileInfo, symbol string) bool {
+	if i.packageName == "" || semanticdb.IsLocal(symbol) {
+		return false
+	}
+
+	resolver := i.packageResolver
+	if resolver == nil {
+		resolver = i.resolver
+	}
+
+	key, ok := resolver.Resolve(symbol, fi.dialect, func(k string) bool {
+		_, ok := i.packages[k]
+		return ok
+	})
+	if !ok {
+		return false
+	}
+
+	if r.importMonikers[key] {
+		return true
+	}
+	if r.importMonikers == nil {
+		r.importMonikers = map[string]bool{}
+	}
+	r.importMonikers[key] = true
+
+	i.emitMoniker("import", r.id, key, i.packages[key])
+	return true
+}
+
+func (i *indexer) emitMoniker(kind string, outV uint64, symbol, packageName string) {
+	monikerID := i.w.EmitMoniker(kind, MonikerScheme, symbol)
+	_ = i.w.EmitMonikerEdge(outV, monikerID)
+	_ = i.w.EmitPackageInformationEdge(monikerID, i.packageInformationID(packageName))
+}
+
+// packageInformationID returns the identifier of the packageInformation vertex
+// of a package, emitting it on first use.
+func (i *indexer) packageInformationID(packageName string) uint64 {
+	if id, ok := i.packageInformationIDs[packageName]; ok {
+		return id
+	}
+
+	id := i.w.EmitPackageInformation(packageName, MonikerScheme, i.packageVersion)
+	i.packageInformationIDs[packageName] = id
+	return id
+}
@@ -0,0 +1,44 @@
+package index
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+func TestImportMonikers(t *testing.T) {
+	var buf bytes.Buffer
+	i := NewIndexer(nil, protocol.ToolInfo{Name: "lsif-semanticdb"}, &buf, Options{
+		SourceRoot: "/src",
+		Monikers: &Monikers{
+			PackageName:    "app",
+			PackageVersion: "1.0.0",
+			Packages:       map[string]string{"core/Box#": "core"},
+		},
+	}).(*indexer)
+
+	box := &pb.Range{StartLine: 0, StartCharacter: 4, EndLine: 0, EndCharacter: 7}
+	err := i.loadDatabase("app.semanticdb", &pb.TextDocuments{Documents: []*pb.TextDocument{{
+		Uri:  "app/App.scala",
+		Text: "new Box",
+		Occurrences: []*pb.SymbolOccurrence{
+			// Occurrences of the same symbol sharing a range
+			{Range: box, Symbol: "core/Box#", Role: pb.SymbolOccurrence_REFERENCE},
+			{Range: box, Symbol: "core/Box#", Role: pb.SymbolOccurrence_REFERENCE},
+		},
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.index(); err != nil {
+		t.Fatal(err)
+	}
+
+	dump := normalizeDump(t, &buf, i.UnresolvedReferences())
+	if n := strings.Count(dump, "moniker import semanticdb:core/Box# core@1.0.0\n"); n != 1 {
+		t.Errorf("got %d import monikers, want 1:\n%s", n, dump)
+	}
+}
@@ -0,0 +1,33 @@
+package index
+
+import "github.com/sourcegraph/lsif-semanticdb/internal/log"
+
+// Options configures an Indexer. The zero value indexes a Scala project
+// rooted at the working directory.
+type Options struct {
+	// SourceRoot is the directory document URIs are relative to
+	SourceRoot string
+
+	// Language is the language of the LSIF project, LanguageScala by default
+	Language string
+
+	// PositionEncoding is the encoding of the character offsets of emitted
+	// ranges, PositionEncodingUTF16 by default
+	PositionEncoding string
+
+	// Contents embeds the text of documents into the dump, base64 encoded
+	Contents bool
+
+	// Filter selects the documents and symbols that are indexed
+	Filter Filter
+
+	// Monikers links global symbols to the symbols of other packages if set
+	Monikers *Monikers
+
+	// Logger receives messages about the progress of indexing, log.Nop by
+	// default
+	Logger log.Logger
+
+	// Progress receives the progress of each phase of indexing if set
+	Progress Progress
+}
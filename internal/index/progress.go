@@ -0,0 +1,80 @@
+package index
+
+import "time"
+
+// Phases of indexing reported to Progress.
+const (
+	PhaseLoading     = "loading"
+	PhaseDocuments   = "documents"
+	PhaseDefinitions = "definitions"
+	PhaseUses        = "uses"
+	PhaseLinking     = "linking"
+)
+
+// ProgressEvent describes the progress of a phase of indexing.
+type ProgressEvent struct {
+	Phase string
+	Done  int
+
+	// Total is the number of items processed by the phase, or 0 if unknown
+	Total int
+
+	// Elapsed is the time since the phase started
+	Elapsed time.Duration
+
+	// Finished is set on the last event of a phase
+	Finished bool
+}
+
+// Progress receives progress events while indexing. The events of a phase
+// start with Done set to zero and end with Finished set.
+type Progress interface {
+	Report(event ProgressEvent)
+}
+
+// ProgressFunc adapts a function to the Progress interface.
+type ProgressFunc func(event ProgressEvent)
+
+// Report calls f(event).
+func (f ProgressFunc) Report(event ProgressEvent) {
+	f(event)
+}
+
+// phaseProgress reports the progress of a single phase.
+type phaseProgress struct {
+	progress Progress
+	phase    string
+	done     int
+	total    int
+	start    time.Time
+}
+
+func (i *indexer) startPhase(phase string, total int) *phaseProgress {
+	p := &phaseProgress{progress: i.progress, phase: phase, total: total, start: time.Now()}
+	p.report(false)
+	return p
+}
+
+// step reports that another item of the phase is processed.
+func (p *phaseProgress) step() {
+	p.done++
+	p.report(false)
+}
+
+func (p *phaseProgress) finish() {
+	p.report(true)
+}
+
+func (p *phaseProgress) report(finished bool) {
+	if p.progress == nil {
+		return
+	}
+
+	p.progress.Report(ProgressEvent{
+		Phase:    p.phase,
+		Done:     p.done,
+		Total:    p.total,
+		Elapsed:  time.Since(p.start),
+		Finished: finished,
+	})
+}
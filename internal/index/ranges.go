@@ -0,0 +1,173 @@
+package index
+
+import (
+	"sort"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+// span is the position of an occurrence in its document.
+type span struct {
+	startLine, startCharacter, endLine, endCharacter int32
+}
+
+// rangeInfo is the range vertex emitted for a span of a document. All
+// occurrences with the same span share it.
+type rangeInfo struct {
+	id          uint64
+	resultSetID uint64     // Zero if the range is not linked to a result set
+	defs        []*defInfo // Definitions of the symbols of the occurrences
+	unresolved  bool       // True if an occurrence has a symbol without definition
+
+	// Symbols of other packages the range has an import moniker of
+	importMonikers map[string]bool
+}
+
+// hasResultSet returns true if an occurrence of the range has the symbol of
+// the given result set.
+func (r *rangeInfo) hasResultSet(resultSetID uint64) bool {
+	for _, def := range r.defs {
+		if def.resultSetID == resultSetID {
+			return true
+		}
+	}
+	return false
+}
+
+// emitRange returns the range vertex of the span of an occurrence, emitting
+// it if no other occurrence of the document has the same span.
+func (i *indexer) emitRange(fi *fileInfo, r *pb.Range) *rangeInfo {
+	key := span{r.StartLine, r.StartCharacter, r.EndLine, r.EndCharacter}
+	if ri, ok := fi.ranges[key]; ok {
+		return ri
+	}
+
+	ri := &rangeInfo{id: i.w.EmitRange(fi.convertRange(r))}
+	fi.ranges[key] = ri
+	fi.rangeInfos = append(fi.rangeInfos, ri)
+	return ri
+}
+
+// linkRange links a range to a result set. A range can only be linked to a
+// single result set, so it returns false if the range already is.
+func (i *indexer) linkRange(ri *rangeInfo, resultSetID uint64) bool {
+	if ri.resultSetID != 0 {
+		return false
+	}
+
+	_ = i.w.EmitNext(ri.id, resultSetID)
+	ri.resultSetID = resultSetID
+	return true
+}
+
+// emitSharedResults attaches results to the ranges whose result set does not
+// cover all of their occurrences. A range shared by occurrences of symbols
+// with different result sets gets a definition, reference and hover result
+// merging those of all symbols, which take precedence over the results of
+// the result set it is linked to. A range with occurrences of symbols without
+// definition gets a reference result that also lists the range itself.
+func (i *indexer) emitSharedResults(fi *fileInfo) {
+	for _, ri := range fi.rangeInfos {
+		if len(ri.defs) < 2 && !ri.unresolved {
+			continue
+		}
+
+		definitions := newRangeSet()
+		references := newRangeSet()
+		var hover []protocol.MarkedString
+		for _, def := range ri.defs {
+			definitions.addAll(def.refResult.defRangeIDs)
+			references.addAll(def.refResult.refRangeIDs)
+			hover = appendMarkedStrings(hover, def.refResult.hover...)
+		}
+		if ri.unresolved {
+			references.add(fi.docID, ri.id)
+		}
+
+		if len(ri.defs) >= 2 {
+			defResultID := i.w.EmitDefinitionResult()
+			_ = i.w.EmitTextDocumentDefinition(ri.id, defResultID)
+			for _, docID := range definitions.docIDs {
+				_ = i.w.EmitItem(defResultID, definitions.rangeIDs[docID], docID)
+			}
+
+			hoverResultID := i.w.EmitHoverResult(hover)
+			_ = i.w.EmitTextDocumentHover(ri.id, hoverResultID)
+		}
+
+		refResultID := i.w.EmitReferenceResult()
+		_ = i.w.EmitTextDocumentReferences(ri.id, refResultID)
+		for _, docID := range definitions.docIDs {
+			_ = i.w.EmitItemOfDefinitions(refResultID, definitions.rangeIDs[docID], docID)
+		}
+		for _, docID := range references.docIDs {
+			var rangeIDs []uint64
+			for _, id := range references.rangeIDs[docID] {
+				if !definitions.contains(docID, id) {
+					rangeIDs = append(rangeIDs, id)
+				}
+			}
+			if len(rangeIDs) > 0 {
+				_ = i.w.EmitItemOfReferences(refResultID, rangeIDs, docID)
+			}
+		}
+	}
+}
+
+// rangeSet collects range ids by document without duplicates, keeping the
+// order in which they were added.
+type rangeSet struct {
+	docIDs   []uint64
+	rangeIDs map[uint64][]uint64 // Keys: document id
+	seen     map[[2]uint64]bool  // Keys: document id and range id
+}
+
+func newRangeSet() *rangeSet {
+	return &rangeSet{rangeIDs: map[uint64][]uint64{}, seen: map[[2]uint64]bool{}}
+}
+
+func (s *rangeSet) add(docID, rangeID uint64) {
+	if s.seen[[2]uint64{docID, rangeID}] {
+		return
+	}
+	s.seen[[2]uint64{docID, rangeID}] = true
+
+	if _, ok := s.rangeIDs[docID]; !ok {
+		s.docIDs = append(s.docIDs, docID)
+	}
+	s.rangeIDs[docID] = append(s.rangeIDs[docID], rangeID)
+}
+
+// addAll adds range ids keyed by document id in the order of the documents.
+func (s *rangeSet) addAll(rangeIDs map[uint64][]uint64) {
+	docIDs := make([]uint64, 0, len(rangeIDs))
+	for docID := range rangeIDs {
+		docIDs = append(docIDs, docID)
+	}
+	sort.Slice(docIDs, func(i, j int) bool { return docIDs[i] < docIDs[j] })
+
+	for _, docID := range docIDs {
+		for _, rangeID := range rangeIDs[docID] {
+			s.add(docID, rangeID)
+		}
+	}
+}
+
+func (s *rangeSet) contains(docID, rangeID uint64) bool {
+	return s.seen[[2]uint64{docID, rangeID}]
+}
+
+// appendMarkedStrings appends the marked strings that are not in the list yet.
+func appendMarkedStrings(list []protocol.MarkedString, strings ...protocol.MarkedString) []protocol.MarkedString {
+outer:
+	for _, s := range strings {
+		for _, t := range list {
+			if t == s {
+				continue outer
+			}
+		}
+		list = append(list, s)
+	}
+	return list
+}
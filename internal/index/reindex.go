@@ -0,0 +1,118 @@
+package index
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/log"
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+// Reindex regenerates the LSIF dump into a new output after the given
+// SemanticDB files were created, modified or removed. Only those files are
+// decoded again; the documents of all other files are reused from the
+// previous call to Index or Reindex.
+func (i *indexer) Reindex(w io.Writer, paths []string) (*Stats, error) {
+	progress := i.startPhase(PhaseLoading, len(paths))
+	for _, path := range paths {
+		progress.step()
+
+		if !i.filter.file(path) {
+			continue
+		}
+		if err := i.reloadDatabase(path); err != nil {
+			return nil, err
+		}
+	}
+	progress.finish()
+
+	i.reset(w)
+	return i.index()
+}
+
+// reloadDatabase replaces the documents of a single SemanticDB file. The file
+// is decoded before the previous documents are dropped, so a file that cannot
+// be read keeps its documents.
+func (i *indexer) reloadDatabase(path string) error {
+	textDocuments, err := semanticdb.ReadFile(path)
+	removed := os.IsNotExist(err)
+	if err != nil && !removed {
+		return fmt.Errorf("load database %s: %v", path, err)
+	}
+
+	previous := i.databases[path]
+	for _, document := range previous {
+		// Duplicates of documents of other files were never indexed
+		if fi, ok := i.files[document.GetUri()]; ok && fi.path == path {
+			delete(i.files, document.GetUri())
+		}
+	}
+	delete(i.databases, path)
+
+	if removed {
+		i.logger.Info("Removed SemanticDB file", log.F("path", path))
+	} else {
+		i.logger.Info("Reloading SemanticDB file", log.F("path", path))
+		if err := i.loadDatabase(path, textDocuments); err != nil {
+			return fmt.Errorf("load database %s: %v", path, err)
+		}
+	}
+
+	return i.restoreDocuments(previous)
+}
+
+// restoreDocuments indexes the given documents again from another SemanticDB
+// file that contains them, e.g. after a document moved to a file that was
+// later removed. Of several such files the first one by path is used.
+func (i *indexer) restoreDocuments(documents []*pb.TextDocument) error {
+	var paths []string
+	for path := range i.databases {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, document := range documents {
+		uri := document.GetUri()
+		if _, ok := i.files[uri]; ok {
+			continue
+		}
+
+	search:
+		for _, path := range paths {
+			for _, candidate := range i.databases[path] {
+				if candidate.GetUri() != uri {
+					continue
+				}
+
+				i.logger.Info("Restoring document", log.F("uri", uri), log.F("path", path))
+				if err := i.loadDocument(path, candidate); err != nil {
+					return fmt.Errorf("load database %s: %v", path, err)
+				}
+				break search
+			}
+		}
+	}
+
+	return nil
+}
+
+// reset discards all state of the previous dump and directs output to w.
+func (i *indexer) reset(w io.Writer) {
+	i.setOutput(w)
+	i.defs = map[string]*defInfo{}
+	i.refs = map[string]*refResultInfo{}
+	i.impls = map[string]map[uint64][]uint64{}
+	i.unresolved = map[string]*UnresolvedReference{}
+	i.packageInformationIDs = map[string]uint64{}
+
+	for _, fi := range i.files {
+		fi.docID = 0
+		fi.ranges = map[span]*rangeInfo{}
+		fi.rangeInfos = nil
+		fi.localDefs = map[string]*defInfo{}
+		fi.localRefs = map[string]*refResultInfo{}
+	}
+}
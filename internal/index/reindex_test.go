@@ -0,0 +1,113 @@
+package index
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestReindex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	document := func(uri, symbol string, role pb.SymbolOccurrence_Role) *pb.TextDocument {
+		return &pb.TextDocument{
+			Uri:      uri,
+			Language: pb.Language_SCALA,
+			Occurrences: []*pb.SymbolOccurrence{
+				{Range: &pb.Range{StartLine: 0, StartCharacter: 6, EndLine: 0, EndCharacter: 7}, Symbol: symbol, Role: role},
+			},
+		}
+	}
+	write := func(name string, documents ...*pb.TextDocument) string {
+		contents, err := proto.Marshal(&pb.TextDocuments{Documents: documents})
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	remove := func(path string) {
+		if err := os.Remove(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	a := write("a.semanticdb", document("A.scala", "a/A#", pb.SymbolOccurrence_DEFINITION))
+	b := write("b.semanticdb", document("B.scala", "a/A#", pb.SymbolOccurrence_REFERENCE))
+
+	i := NewIndexer([]string{dir}, protocol.ToolInfo{Name: "lsif-semanticdb"}, ioutil.Discard, Options{SourceRoot: dir}).(*indexer)
+	if _, err := i.Index(); err != nil {
+		t.Fatal(err)
+	}
+
+	// paths returns the file each document is indexed from
+	paths := func() map[string]string {
+		paths := map[string]string{}
+		for uri, fi := range i.files {
+			paths[uri] = filepath.Base(fi.path)
+		}
+		return paths
+	}
+	reindex := func(want map[string]string, changed ...string) {
+		t.Helper()
+		stats, err := i.Reindex(ioutil.Discard, changed)
+		if err != nil {
+			t.Fatalf("Reindex(%v): %v", changed, err)
+		}
+		if got := paths(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Reindex(%v): documents %v, want %v", changed, got, want)
+		}
+		if stats.NumFiles != uint(len(want)) {
+			t.Fatalf("Reindex(%v): %d files, want %d", changed, stats.NumFiles, len(want))
+		}
+	}
+
+	// A file that cannot be decoded keeps its previous documents
+	if err := ioutil.WriteFile(a, []byte("not a semanticdb file"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Reindex(ioutil.Discard, []string{a}); err == nil {
+		t.Fatalf("expected an error for an invalid file")
+	}
+	if got, want := paths(), map[string]string{"A.scala": "a.semanticdb", "B.scala": "b.semanticdb"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("documents after invalid file %v, want %v", got, want)
+	}
+
+	// Modified files replace their documents
+	write("a.semanticdb", document("A.scala", "a/A#", pb.SymbolOccurrence_DEFINITION), document("A2.scala", "a/A2#", pb.SymbolOccurrence_DEFINITION))
+	reindex(map[string]string{"A.scala": "a.semanticdb", "A2.scala": "a.semanticdb", "B.scala": "b.semanticdb"}, a)
+
+	// A document moved to another file is indexed from there once the first
+	// file is removed, whatever the order of the changes
+	c := write("c.semanticdb", document("A.scala", "a/A#", pb.SymbolOccurrence_DEFINITION))
+	remove(a)
+	reindex(map[string]string{"A.scala": "c.semanticdb", "B.scala": "b.semanticdb"}, c, a)
+
+	// A document that is also in another file is restored from it when the
+	// file it was indexed from is removed
+	write("d.semanticdb", document("A.scala", "a/A#", pb.SymbolOccurrence_DEFINITION))
+	reindex(map[string]string{"A.scala": "c.semanticdb", "B.scala": "b.semanticdb"}, filepath.Join(dir, "d.semanticdb"))
+	remove(c)
+	reindex(map[string]string{"A.scala": "d.semanticdb", "B.scala": "b.semanticdb"}, c)
+
+	remove(filepath.Join(dir, "d.semanticdb"))
+	remove(b)
+	reindex(map[string]string{}, filepath.Join(dir, "d.semanticdb"), b)
+
+	if len(i.databases) != 0 {
+		t.Errorf("files are still known after their removal: %v", i.databases)
+	}
+}
@@ -0,0 +1,120 @@
+package index
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+// Problems of occurrences that are dropped when loading a document.
+const (
+	problemNoRange      = "occurrence without range"
+	problemNoSymbol     = "occurrence without symbol"
+	problemInvalidRange = "negative or inverted range"
+	problemOutOfBounds  = "range starts beyond the text"
+)
+
+// sanitizeDocument removes the occurrences of a document the indexer cannot
+// emit, so that malformed SemanticDB files do not produce bogus ranges. If the
+// text of the document is known, ranges ending beyond the end of a line or of
+// the text are clamped and ranges starting beyond it are removed. It returns
+// the number of removed occurrences per problem and the number of clamped
+// ranges.
+func sanitizeDocument(document *pb.TextDocument, text string) (map[string]int, int) {
+	var lines []int
+	if text != "" {
+		lines = lineLengths(text)
+	}
+
+	var problems map[string]int
+	numClamped := 0
+
+	occurrences := document.Occurrences[:0]
+	for _, occurrence := range document.GetOccurrences() {
+		r := occurrence.GetRange()
+
+		var problem string
+		switch {
+		case r == nil:
+			problem = problemNoRange
+		case occurrence.GetSymbol() == "":
+			problem = problemNoSymbol
+		case r.StartLine < 0 || r.StartCharacter < 0 || r.EndLine < 0 || r.EndCharacter < 0:
+			problem = problemInvalidRange
+		case r.EndLine < r.StartLine || r.EndLine == r.StartLine && r.EndCharacter < r.StartCharacter:
+			problem = problemInvalidRange
+		case lines != nil && (int(r.StartLine) >= len(lines) || int(r.StartCharacter) > lines[r.StartLine]):
+			problem = problemOutOfBounds
+		}
+
+		if problem == "" {
+			if lines != nil && clampRange(r, lines) {
+				numClamped++
+			}
+			occurrences = append(occurrences, occurrence)
+			continue
+		}
+
+		if problems == nil {
+			problems = map[string]int{}
+		}
+		problems[problem]++
+	}
+	document.Occurrences = occurrences
+
+	return problems, numClamped
+}
+
+// clampRange moves the end of a range that lies beyond the end of its line or
+// of the text to the end of that line or text. It returns true if the range
+// was changed.
+func clampRange(r *pb.Range, lines []int) bool {
+	clamped := false
+	if int(r.EndLine) >= len(lines) {
+		r.EndLine = int32(len(lines) - 1)
+		r.EndCharacter = int32(lines[r.EndLine])
+		clamped = true
+	}
+	if int(r.EndCharacter) > lines[r.EndLine] {
+		r.EndCharacter = int32(lines[r.EndLine])
+		clamped = true
+	}
+	return clamped
+}
+
+// lineLengths returns the length of each line of a text in UTF-16 code units,
+// the unit of SemanticDB character offsets.
+func lineLengths(text string) []int {
+	var lengths []int
+	for _, line := range strings.Split(text, "\n") {
+		n := 0
+		for _, r := range line {
+			n += semanticdb.UTF16Len(r)
+		}
+		lengths = append(lengths, n)
+	}
+	return lengths
+}
+
+// documentText returns the text of a document, reading it from the source root
+// if the document does not contain it. It returns the empty string if the
+// source file cannot be read or its URI leads out of the source root.
+func (i *indexer) documentText(document *pb.TextDocument) string {
+	if text := document.GetText(); text != "" {
+		return text
+	}
+
+	rel := filepath.Clean(filepath.FromSlash(document.GetUri()))
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return ""
+	}
+
+	contents, err := ioutil.ReadFile(filepath.Join(i.sourceRoot, rel))
+	if err != nil {
+		return ""
+	}
+	return string(contents)
+}
@@ -0,0 +1,51 @@
+package index
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+func TestDocumentText(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	sourceRoot := filepath.Join(dir, "src")
+	if err := os.MkdirAll(filepath.Join(sourceRoot, "a"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for path, text := range map[string]string{"src/a/A.scala": "object A", "secret.txt": "secret"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, path), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := NewIndexer(nil, protocol.ToolInfo{Name: "lsif-semanticdb"}, ioutil.Discard, Options{SourceRoot: sourceRoot}).(*indexer)
+
+	testCases := []struct {
+		document *pb.TextDocument
+		want     string
+	}{
+		{&pb.TextDocument{Uri: "a/A.scala", Text: "object B"}, "object B"},
+		{&pb.TextDocument{Uri: "a/A.scala"}, "object A"},
+		{&pb.TextDocument{Uri: "a/../a/A.scala"}, "object A"},
+		{&pb.TextDocument{Uri: "a/B.scala"}, ""},
+		// Source files are only read below the source root
+		{&pb.TextDocument{Uri: "../secret.txt"}, ""},
+		{&pb.TextDocument{Uri: "a/../../secret.txt"}, ""},
+		{&pb.TextDocument{Uri: filepath.ToSlash(filepath.Join(dir, "secret.txt"))}, ""},
+	}
+
+	for _, testCase := range testCases {
+		if text := i.documentText(testCase.document); text != testCase.want {
+			t.Errorf("documentText(%q) = %q, want %q", testCase.document.GetUri(), text, testCase.want)
+		}
+	}
+}
@@ -1,15 +1,23 @@
 package index
 
-import pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+import (
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
 
 type fileInfo struct {
+	path        string
 	document    *pb.TextDocument
+	dialect     semanticdb.Dialect
+	positions   *positionConverter
 	symbols     map[string]*pb.SymbolInformation
 	docID       uint64
-	defRangeIDs []uint64
-	useRangeIDs []uint64
+	ranges      map[span]*rangeInfo
+	rangeInfos  []*rangeInfo // In the order the ranges were emitted
 	localDefs   map[string]*defInfo
 	localRefs   map[string]*refResultInfo
+	numRejected int
 }
 
 type defInfo struct {
@@ -17,10 +25,13 @@ type defInfo struct {
 	rangeID     uint64
 	resultSetID uint64
 	defResultID uint64
+	refResult   *refResultInfo
 }
 
 type refResultInfo struct {
 	resultSetID uint64
 	defRangeIDs map[uint64][]uint64
 	refRangeIDs map[uint64][]uint64
+	defResultID uint64                  // Emitted with the first definition
+	hover       []protocol.MarkedString // Contents of the hover result of the first definition
 }
@@ -0,0 +1,125 @@
+package index
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/log"
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+// maxUnresolvedSamples is the number of locations recorded per unresolved symbol.
+const maxUnresolvedSamples = 5
+
+// Kinds of unresolved references.
+const (
+	// UnresolvedStdlib is a reference into the Scala or Java standard library.
+	UnresolvedStdlib = "stdlib"
+	// UnresolvedDependency is a reference into a package without any indexed
+	// definitions, typically a library dependency.
+	UnresolvedDependency = "dependency"
+	// UnresolvedPackage is a reference to a package, which never has a
+	// definition occurrence.
+	UnresolvedPackage = "package"
+	// UnresolvedInternal is a reference into a package with indexed
+	// definitions. These usually indicate a resolution bug.
+	UnresolvedInternal = "internal"
+)
+
+// stdlibPackages are the package prefixes of the standard libraries.
+var stdlibPackages = []string{"scala/", "java/", "javax/", "jdk/", "sun/"}
+
+// UnresolvedReference describes a symbol whose references could not be
+// linked to a definition.
+type UnresolvedReference struct {
+	Symbol      string     `json:"symbol"`
+	Kind        string     `json:"kind"`
+	Occurrences int        `json:"occurrences"`
+	Samples     []Location `json:"samples"`
+}
+
+// Location is a position within a document.
+type Location struct {
+	URI       string `json:"uri"`
+	Line      int    `json:"line"`
+	Character int    `json:"character"`
+}
+
+// recordUnresolved notes a reference occurrence that could not be linked to
+// a definition.
+func (i *indexer) recordUnresolved(uri string, occurrence *pb.SymbolOccurrence) {
+	key := occurrence.GetSymbol()
+	if semanticdb.IsLocal(key) {
+		// Local symbols are only unique within a document
+		key = uri + " " + key
+	}
+
+	u, ok := i.unresolved[key]
+	if !ok {
+		u = &UnresolvedReference{Symbol: occurrence.GetSymbol()}
+		i.unresolved[key] = u
+	}
+
+	u.Occurrences++
+	i.logger.Debug("Unresolved reference",
+		log.F("uri", uri),
+		log.F("symbol", occurrence.GetSymbol()),
+		log.F("line", occurrence.GetRange().GetStartLine()))
+	if len(u.Samples) < maxUnresolvedSamples {
+		u.Samples = append(u.Samples, Location{
+			URI:       uri,
+			Line:      int(occurrence.GetRange().GetStartLine()),
+			Character: int(occurrence.GetRange().GetStartCharacter()),
+		})
+	}
+}
+
+// UnresolvedReferences returns the symbols whose references could not be
+// linked to a definition during the last call to Index, ordered by number of
+// occurrences.
+func (i *indexer) UnresolvedReferences() []*UnresolvedReference {
+	packages := map[string]bool{}
+	for key := range i.defs {
+		packages[semanticdb.Package(key)] = true
+	}
+
+	unresolved := make([]*UnresolvedReference, 0, len(i.unresolved))
+	for _, u := range i.unresolved {
+		u.Kind = classifyUnresolved(u.Symbol, packages)
+		unresolved = append(unresolved, u)
+	}
+
+	sort.Slice(unresolved, func(a, b int) bool {
+		if unresolved[a].Occurrences != unresolved[b].Occurrences {
+			return unresolved[a].Occurrences > unresolved[b].Occurrences
+		}
+		return unresolved[a].Symbol < unresolved[b].Symbol
+	})
+
+	return unresolved
+}
+
+// classifyUnresolved guesses why a symbol could not be resolved, given the
+// set of packages that contain indexed definitions.
+func classifyUnresolved(symbol string, packages map[string]bool) string {
+	if semanticdb.IsLocal(symbol) {
+		return UnresolvedInternal
+	}
+
+	if strings.HasSuffix(symbol, "/") {
+		return UnresolvedPackage
+	}
+
+	for _, prefix := range stdlibPackages {
+		if strings.HasPrefix(symbol, prefix) {
+			return UnresolvedStdlib
+		}
+	}
+
+	if packages[semanticdb.Package(symbol)] {
+		return UnresolvedInternal
+	}
+
+	return UnresolvedDependency
+}
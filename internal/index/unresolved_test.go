@@ -0,0 +1,30 @@
+package index
+
+import "testing"
+
+func TestClassifyUnresolved(t *testing.T) {
+	packages := map[string]bool{"a/": true, "a/b/": true}
+
+	testCases := []struct {
+		symbol string
+		kind   string
+	}{
+		{"scala/Predef.println().", UnresolvedStdlib},
+		{"java/lang/String#", UnresolvedStdlib},
+		{"javax/inject/Inject#", UnresolvedStdlib},
+		{"cats/effect/IO#", UnresolvedDependency},
+		{"ab/C#", UnresolvedDependency},
+		{"a/", UnresolvedPackage},
+		{"scala/collection/", UnresolvedPackage},
+		{"cats/", UnresolvedPackage},
+		{"a/C#", UnresolvedInternal},
+		{"a/b/C#f().", UnresolvedInternal},
+		{"local0", UnresolvedInternal},
+	}
+
+	for _, testCase := range testCases {
+		if kind := classifyUnresolved(testCase.symbol, packages); kind != testCase.kind {
+			t.Errorf("classifyUnresolved(%q) = %q, want %q", testCase.symbol, kind, testCase.kind)
+		}
+	}
+}
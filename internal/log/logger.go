@@ -0,0 +1,175 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Logger writes leveled messages described by key-value fields. A Logger
+// carries its own level and output so that several can be used in one
+// process.
+type Logger interface {
+	Debug(msg string, fields ...Field)
+	Info(msg string, fields ...Field)
+	Warn(msg string, fields ...Field)
+	Error(msg string, fields ...Field)
+
+	// With returns a Logger adding the fields to every message.
+	With(fields ...Field) Logger
+}
+
+// Field is a key-value pair describing a message.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// F creates a Field.
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Format is the encoding of the messages of a Logger.
+type Format int
+
+// Formats of Logger messages.
+const (
+	// Text writes a line per message with the level, the message and the fields.
+	Text Format = iota
+	// JSON writes a JSON object per line.
+	JSON
+)
+
+// ParseFormat returns the Format with the given name.
+func ParseFormat(name string) (Format, error) {
+	switch name {
+	case "text":
+		return Text, nil
+	case "json":
+		return JSON, nil
+	}
+
+	return 0, fmt.Errorf("unknown log format %q", name)
+}
+
+// String returns the lower case name of the level.
+func (l Level) String() string {
+	switch l {
+	case Debug:
+		return "debug"
+	case Info:
+		return "info"
+	case Warn:
+		return "warn"
+	case Error:
+		return "error"
+	}
+
+	return "none"
+}
+
+// New creates a Logger writing messages at or above the given level to w.
+// The Logger is safe for concurrent use.
+func New(w io.Writer, level Level, format Format) Logger {
+	return &logger{
+		output: &output{w: w},
+		level:  level,
+		format: format,
+	}
+}
+
+// Nop is a Logger discarding all messages.
+var Nop Logger = New(ioutil.Discard, None, Text)
+
+type logger struct {
+	*output
+	level  Level
+	format Format
+	fields []Field
+}
+
+// output serializes writes of a Logger and the Loggers derived from it.
+type output struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (l *logger) Debug(msg string, fields ...Field) { l.log(Debug, msg, fields) }
+func (l *logger) Info(msg string, fields ...Field)  { l.log(Info, msg, fields) }
+func (l *logger) Warn(msg string, fields ...Field)  { l.log(Warn, msg, fields) }
+func (l *logger) Error(msg string, fields ...Field) { l.log(Error, msg, fields) }
+
+func (l *logger) With(fields ...Field) Logger {
+	derived := *l
+	derived.fields = append(append([]Field(nil), l.fields...), fields...)
+	return &derived
+}
+
+func (l *logger) log(level Level, msg string, fields []Field) {
+	if level < l.level {
+		return
+	}
+
+	fields = append(append([]Field(nil), l.fields...), fields...)
+
+	var line string
+	if l.format == JSON {
+		line = formatJSON(time.Now(), level, msg, fields)
+	} else {
+		line = FormatText(level, msg, fields)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = io.WriteString(l.w, line+"\n")
+}
+
+// FormatText formats a message as a line of text, e.g.
+// `WARN  duplicate symbol uri=a.scala symbol="a/b c#"`.
+func FormatText(level Level, msg string, fields []Field) string {
+	return fmt.Sprintf("%-5s %s", strings.ToUpper(level.String()), FormatMessage(msg, fields))
+}
+
+// FormatMessage formats a message as a line of text without its level, e.g.
+// `duplicate symbol uri=a.scala symbol="a/b c#"`.
+func FormatMessage(msg string, fields []Field) string {
+	var b strings.Builder
+	b.WriteString(msg)
+
+	for _, field := range fields {
+		value := fmt.Sprint(field.Value)
+		if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+			value = strconv.Quote(value)
+		}
+		fmt.Fprintf(&b, " %s=%s", field.Key, value)
+	}
+
+	return b.String()
+}
+
+func formatJSON(t time.Time, level Level, msg string, fields []Field) string {
+	object := make(map[string]interface{}, len(fields)+3)
+	for _, field := range fields {
+		value := field.Value
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+		object[field.Key] = value
+	}
+	object["time"] = t.UTC().Format(time.RFC3339Nano)
+	object["level"] = level.String()
+	object["msg"] = msg
+
+	contents, err := json.Marshal(object)
+	if err != nil {
+		contents, _ = json.Marshal(map[string]string{"level": level.String(), "msg": msg, "error": err.Error()})
+	}
+
+	return string(contents)
+}
@@ -0,0 +1,150 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLoggerLevels(t *testing.T) {
+	var b bytes.Buffer
+	logger := New(&b, Warn, Text)
+
+	logger.Debug("debug")
+	logger.Info("info")
+	logger.Warn("warn")
+	logger.Error("error")
+
+	if got, want := b.String(), "WARN  warn\nERROR error\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	b.Reset()
+	Nop.Error("error")
+	New(&b, None, Text).Error("error")
+	if b.Len() != 0 {
+		t.Errorf("disabled logger wrote %q", b.String())
+	}
+}
+
+func TestLoggerWith(t *testing.T) {
+	var b bytes.Buffer
+	logger := New(&b, Debug, Text)
+	derived := logger.With(F("module", "core"))
+
+	derived.With(F("uri", "a.scala")).Info("loaded", F("count", 2))
+	derived.Info("done")
+	logger.Info("unchanged")
+
+	want := "INFO  loaded module=core uri=a.scala count=2\n" +
+		"INFO  done module=core\n" +
+		"INFO  unchanged\n"
+	if got := b.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestFormatText(t *testing.T) {
+	fields := []Field{
+		F("symbol", "a/b c#"),
+		F("empty", ""),
+		F("quote", `a"b`),
+		F("pair", "a=b"),
+		F("error", errors.New("failed")),
+		F("count", 3),
+	}
+
+	want := `DEBUG message symbol="a/b c#" empty="" quote="a\"b" pair="a=b" error=failed count=3`
+	if got := FormatText(Debug, "message", fields); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	want = `message symbol="a/b c#" empty="" quote="a\"b" pair="a=b" error=failed count=3`
+	if got := FormatMessage("message", fields); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestFormatJSON(t *testing.T) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.FixedZone("", 3600))
+	line := formatJSON(now, Error, "failed", []Field{F("error", errors.New("boom")), F("count", 2), F("msg", "overridden")})
+
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &object); err != nil {
+		t.Fatalf("invalid JSON %q: %v", line, err)
+	}
+
+	want := map[string]interface{}{
+		"time":  "2020-01-02T02:04:05Z",
+		"level": "error",
+		"msg":   "failed",
+		"error": "boom",
+		"count": 2.0,
+	}
+	if len(object) != len(want) {
+		t.Errorf("got %v, want %v", object, want)
+	}
+	for key, value := range want {
+		if object[key] != value {
+			t.Errorf("%s: got %v, want %v", key, object[key], value)
+		}
+	}
+
+	// Values that cannot be encoded are reported instead of the fields
+	line = formatJSON(now, Info, "message", []Field{F("channel", make(chan int))})
+	if err := json.Unmarshal([]byte(line), &object); err != nil || object["msg"] != "message" || object["error"] == nil {
+		t.Errorf("unexpected line for an invalid field %q", line)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, want := range map[string]Format{"text": Text, "json": JSON} {
+		if got, err := ParseFormat(name); err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %v, %v, want %v", name, got, err, want)
+		}
+	}
+
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Errorf("expected an error for an unknown format")
+	}
+}
+
+func TestLevelString(t *testing.T) {
+	for level, want := range map[Level]string{Debug: "debug", Info: "info", Warn: "warn", Error: "error", None: "none"} {
+		if got := level.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", level, got, want)
+		}
+	}
+}
+
+func TestLoggerConcurrentUse(t *testing.T) {
+	var b bytes.Buffer
+	logger := New(&b, Info, JSON)
+
+	var wg sync.WaitGroup
+	for n := 0; n < 10; n++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			derived := logger.With(F("worker", n))
+			for i := 0; i < 10; i++ {
+				derived.Info("message", F("i", i))
+			}
+		}(n)
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(lines) != 100 {
+		t.Fatalf("got %d lines, want 100", len(lines))
+	}
+	for _, line := range lines {
+		if !json.Valid([]byte(line)) {
+			t.Errorf("interleaved line %q", line)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package lsif
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Statuses of documents and ranges in a diff.
+const (
+	Added   = "added"
+	Removed = "removed"
+	Changed = "changed"
+)
+
+// Kinds of results compared by Diff.
+const (
+	ResultDefinition     = "definition"
+	ResultReference      = "reference"
+	ResultImplementation = "implementation"
+	ResultHover          = "hover"
+	ResultMoniker        = "moniker"
+)
+
+// ResultKinds lists the kinds of results in the order they are reported.
+var ResultKinds = []string{ResultDefinition, ResultReference, ResultImplementation, ResultHover, ResultMoniker}
+
+// DocumentDiff lists the changes to the navigation data of a document.
+type DocumentDiff struct {
+	URI    string       `json:"uri"`
+	Status string       `json:"status"`
+	Ranges []*RangeDiff `json:"ranges,omitempty"`
+}
+
+// RangeDiff lists the results added to or removed from a range. Ranges are
+// identified by their position; the results of ranges with the same position
+// are combined.
+type RangeDiff struct {
+	Range   string        `json:"range"`
+	Status  string        `json:"status"`
+	Results []*ResultDiff `json:"results"`
+}
+
+// ResultDiff lists the results of a kind added to or removed from a range.
+// Definitions, references and implementations are formatted as locations, hovers as quoted
+// strings and monikers with their package.
+type ResultDiff struct {
+	Kind    string   `json:"kind"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// Diff compares the documents of two dumps. Documents only present in one
+// dump are reported without the changes to their ranges. The diffs are
+// sorted by URI and position.
+func Diff(old, new []*Document) []*DocumentDiff {
+	oldDocuments := documentsByURI(old)
+	newDocuments := documentsByURI(new)
+
+	var diffs []*DocumentDiff
+	for uri, document := range oldDocuments {
+		if _, ok := newDocuments[uri]; !ok {
+			diffs = append(diffs, &DocumentDiff{URI: document.URI, Status: Removed})
+		}
+	}
+	for uri, document := range newDocuments {
+		oldDocument, ok := oldDocuments[uri]
+		if !ok {
+			diffs = append(diffs, &DocumentDiff{URI: document.URI, Status: Added})
+			continue
+		}
+
+		if ranges := diffRanges(oldDocument, document); len(ranges) > 0 {
+			diffs = append(diffs, &DocumentDiff{URI: document.URI, Status: Changed, Ranges: ranges})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].URI < diffs[j].URI })
+	return diffs
+}
+
+func documentsByURI(documents []*Document) map[string]*Document {
+	m := make(map[string]*Document, len(documents))
+	for _, document := range documents {
+		m[document.URI] = document
+	}
+	return m
+}
+
+// rangeResults maps each kind of result of a range to the set of results.
+type rangeResults map[string]map[string]bool
+
+// spans combines the results of the ranges of a document by position.
+type spans struct {
+	order   []*Range
+	results map[string]rangeResults // Keys: span
+}
+
+func newSpans(document *Document) *spans {
+	s := &spans{results: map[string]rangeResults{}}
+	for _, r := range document.Ranges {
+		key := r.String()
+		results, ok := s.results[key]
+		if !ok {
+			results = rangeResults{}
+			for _, kind := range ResultKinds {
+				results[kind] = map[string]bool{}
+			}
+			s.results[key] = results
+			s.order = append(s.order, r)
+		}
+
+		for _, location := range r.Definitions {
+			results[ResultDefinition][location.String()] = true
+		}
+		for _, location := range r.References {
+			results[ResultReference][location.String()] = true
+		}
+		for _, location := range r.Implementations {
+			results[ResultImplementation][location.String()] = true
+		}
+		for _, hover := range r.Hover {
+			results[ResultHover][strconv.Quote(hover)] = true
+		}
+		for _, moniker := range r.Monikers {
+			results[ResultMoniker][moniker] = true
+		}
+	}
+	return s
+}
+
+func diffRanges(old, new *Document) []*RangeDiff {
+	oldSpans := newSpans(old)
+	newSpans := newSpans(new)
+
+	type positioned struct {
+		r    *Range
+		diff *RangeDiff
+	}
+	var diffs []positioned
+
+	for _, r := range oldSpans.order {
+		if _, ok := newSpans.results[r.String()]; !ok {
+			diffs = append(diffs, positioned{r, &RangeDiff{
+				Range:   r.String(),
+				Status:  Removed,
+				Results: diffResults(oldSpans.results[r.String()], nil),
+			}})
+		}
+	}
+	for _, r := range newSpans.order {
+		key := r.String()
+		oldResults, ok := oldSpans.results[key]
+		if !ok {
+			diffs = append(diffs, positioned{r, &RangeDiff{
+				Range:   key,
+				Status:  Added,
+				Results: diffResults(nil, newSpans.results[key]),
+			}})
+			continue
+		}
+
+		if results := diffResults(oldResults, newSpans.results[key]); len(results) > 0 {
+			diffs = append(diffs, positioned{r, &RangeDiff{Range: key, Status: Changed, Results: results}})
+		}
+	}
+
+	sort.SliceStable(diffs, func(i, j int) bool {
+		if c := comparePos(diffs[i].r.Start, diffs[j].r.Start); c != 0 {
+			return c < 0
+		}
+		return comparePos(diffs[i].r.End, diffs[j].r.End) < 0
+	})
+
+	rangeDiffs := make([]*RangeDiff, 0, len(diffs))
+	for _, d := range diffs {
+		rangeDiffs = append(rangeDiffs, d.diff)
+	}
+	return rangeDiffs
+}
+
+// diffResults compares the results of a range. Either side may be nil.
+func diffResults(old, new rangeResults) []*ResultDiff {
+	var diffs []*ResultDiff
+	for _, kind := range ResultKinds {
+		diff := &ResultDiff{Kind: kind}
+		for result := range old[kind] {
+			if !new[kind][result] {
+				diff.Removed = append(diff.Removed, result)
+			}
+		}
+		for result := range new[kind] {
+			if !old[kind][result] {
+				diff.Added = append(diff.Added, result)
+			}
+		}
+
+		if len(diff.Added) > 0 || len(diff.Removed) > 0 {
+			sort.Strings(diff.Added)
+			sort.Strings(diff.Removed)
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs
+}
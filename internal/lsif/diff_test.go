@@ -0,0 +1,69 @@
+package lsif
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+func TestDiff(t *testing.T) {
+	span := func(line, start, end int) (protocol.Pos, protocol.Pos) {
+		return protocol.Pos{Line: line, Character: start}, protocol.Pos{Line: line, Character: end}
+	}
+	location := func(uri string, line, start, end int) Location {
+		s, e := span(line, start, end)
+		return Location{URI: uri, Start: s, End: e}
+	}
+	newRange := func(line, start, end int, definitions []Location, hover ...string) *Range {
+		s, e := span(line, start, end)
+		return &Range{Start: s, End: e, Definitions: definitions, Hover: hover}
+	}
+
+	foo := location("a.scala", 0, 6, 9)
+	old := []*Document{
+		{URI: "a.scala", Ranges: []*Range{
+			newRange(0, 6, 9, []Location{foo}, "class Foo"),
+			newRange(1, 0, 3, []Location{foo}),
+		}},
+		{URI: "removed.scala"},
+	}
+	new := []*Document{
+		{URI: "a.scala", Ranges: []*Range{
+			// Duplicate ranges are combined
+			newRange(0, 6, 9, []Location{foo}),
+			newRange(0, 6, 9, nil, "case class Foo"),
+			newRange(2, 0, 3, []Location{foo}),
+		}},
+		{URI: "added.scala"},
+	}
+
+	want := []*DocumentDiff{
+		{URI: "a.scala", Status: Changed, Ranges: []*RangeDiff{
+			{Range: "1:7-1:10", Status: Changed, Results: []*ResultDiff{
+				{Kind: ResultHover, Added: []string{`"case class Foo"`}, Removed: []string{`"class Foo"`}},
+			}},
+			{Range: "2:1-2:4", Status: Removed, Results: []*ResultDiff{
+				{Kind: ResultDefinition, Removed: []string{"a.scala:1:7-1:10"}},
+			}},
+			{Range: "3:1-3:4", Status: Added, Results: []*ResultDiff{
+				{Kind: ResultDefinition, Added: []string{"a.scala:1:7-1:10"}},
+			}},
+		}},
+		{URI: "added.scala", Status: Added},
+		{URI: "removed.scala", Status: Removed},
+	}
+
+	if got := Diff(old, new); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() differs from expected diff")
+		for _, document := range got {
+			t.Logf("%s %s", document.URI, document.Status)
+			for _, r := range document.Ranges {
+				t.Logf("  %s %s", r.Range, r.Status)
+				for _, result := range r.Results {
+					t.Logf("    %+v", *result)
+				}
+			}
+		}
+	}
+}
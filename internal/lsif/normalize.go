@@ -0,0 +1,343 @@
+package lsif
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+// Document is the navigation data of a document in a dump. It does not
+// depend on the identifiers or the order of the elements of the dump, so the
+// documents of two dumps can be compared.
+type Document struct {
+	// URI is relative to the project root if the document is below it
+	URI      string
+	Language string
+	Ranges   []*Range
+}
+
+// Range is a range of a document with the results attached to it directly
+// or through its result sets.
+type Range struct {
+	Start, End      protocol.Pos
+	Definitions     []Location
+	References      []Location
+	Implementations []Location
+	Hover           []string
+	Monikers        []string
+}
+
+// Location is a range in a document.
+type Location struct {
+	URI        string
+	Start, End protocol.Pos
+}
+
+func (l Location) String() string {
+	return fmt.Sprintf("%s:%s", l.URI, formatSpan(l.Start, l.End))
+}
+
+func (r *Range) String() string {
+	return formatSpan(r.Start, r.End)
+}
+
+// formatSpan formats a span as 1-based line and character numbers as most
+// editors display them.
+func formatSpan(start, end protocol.Pos) string {
+	return fmt.Sprintf("%d:%d-%d:%d", start.Line+1, start.Character+1, end.Line+1, end.Character+1)
+}
+
+// Documents extracts the navigation data of each document of a dump. The
+// documents are sorted by URI and their ranges by position.
+func Documents(elements []*Element) ([]*Document, error) {
+	g := newGraph(elements)
+
+	var documents []*Document
+	for _, e := range elements {
+		if !e.IsVertex() || e.Label != "document" {
+			continue
+		}
+
+		document := &Document{URI: g.relativeURI(e.URI), Language: e.LanguageID}
+		for _, id := range g.contains[e.ID] {
+			v, ok := g.vertices[id]
+			if !ok || v.Label != "range" {
+				continue
+			}
+			if v.Start == nil || v.End == nil {
+				return nil, fmt.Errorf("range %d has no position", id)
+			}
+
+			r, err := g.rangeResults(v)
+			if err != nil {
+				return nil, err
+			}
+			document.Ranges = append(document.Ranges, r)
+		}
+
+		sort.Slice(document.Ranges, func(i, j int) bool {
+			return compareRanges(document.Ranges[i], document.Ranges[j]) < 0
+		})
+		documents = append(documents, document)
+	}
+
+	sort.Slice(documents, func(i, j int) bool { return documents[i].URI < documents[j].URI })
+	return documents, nil
+}
+
+// Normalize writes the navigation data of a dump as text, with a block per
+// document and a line per result of each range. Dumps with the same
+// navigation data are normalized identically.
+func Normalize(w io.Writer, elements []*Element) error {
+	documents, err := Documents(elements)
+	if err != nil {
+		return err
+	}
+
+	for _, document := range documents {
+		fmt.Fprintf(w, "document %s %s\n", document.URI, document.Language)
+
+		for _, r := range document.Ranges {
+			fmt.Fprintf(w, "  range %s\n", r)
+			for _, hover := range r.Hover {
+				fmt.Fprintf(w, "    hover %q\n", hover)
+			}
+			for _, location := range r.Definitions {
+				fmt.Fprintf(w, "    definition %s\n", location)
+			}
+			for _, location := range r.References {
+				fmt.Fprintf(w, "    reference %s\n", location)
+			}
+			for _, location := range r.Implementations {
+				fmt.Fprintf(w, "    implementation %s\n", location)
+			}
+			for _, moniker := range r.Monikers {
+				fmt.Fprintf(w, "    moniker %s\n", moniker)
+			}
+		}
+	}
+
+	return nil
+}
+
+// graph indexes the edges of a dump by their out vertex.
+type graph struct {
+	root     string
+	vertices map[uint64]*Element
+	contains map[uint64][]uint64
+	edges    map[string]map[uint64][]*Element // Keys: label, out vertex
+}
+
+func newGraph(elements []*Element) *graph {
+	g := &graph{
+		vertices: map[uint64]*Element{},
+		contains: map[uint64][]uint64{},
+		edges:    map[string]map[uint64][]*Element{},
+	}
+
+	for _, e := range elements {
+		switch {
+		case e.IsVertex():
+			g.vertices[e.ID] = e
+			if e.Label == "metaData" {
+				g.root = strings.TrimSuffix(e.Root, "/") + "/"
+			}
+
+		case e.Label == "contains":
+			g.contains[e.OutV] = append(g.contains[e.OutV], e.InVs...)
+
+		default:
+			if g.edges[e.Label] == nil {
+				g.edges[e.Label] = map[uint64][]*Element{}
+			}
+			g.edges[e.Label][e.OutV] = append(g.edges[e.Label][e.OutV], e)
+		}
+	}
+
+	return g
+}
+
+func (g *graph) relativeURI(uri string) string {
+	if g.root != "/" && strings.HasPrefix(uri, g.root) {
+		return strings.TrimPrefix(uri, g.root)
+	}
+	return uri
+}
+
+// rangeResults collects the results of a range and of the result sets it is
+// linked to with next edges. As for LSIF clients, a result of the range takes
+// precedence over the results of the same kind of its result sets, while
+// monikers are collected from all of them.
+func (g *graph) rangeResults(v *Element) (*Range, error) {
+	r := &Range{Start: *v.Start, End: *v.End}
+
+	seen := map[uint64]bool{}
+	for id := v.ID; id != 0 && !seen[id]; id = g.next(id) {
+		seen[id] = true
+
+		if r.Definitions == nil {
+			for _, resultID := range g.targets("textDocument/definition", id) {
+				r.Definitions = append(r.Definitions, g.items(resultID)...)
+			}
+		}
+		if r.References == nil {
+			for _, resultID := range g.targets("textDocument/references", id) {
+				r.References = append(r.References, g.items(resultID)...)
+			}
+		}
+		if r.Implementations == nil {
+			for _, resultID := range g.targets("textDocument/implementation", id) {
+				r.Implementations = append(r.Implementations, g.items(resultID)...)
+			}
+		}
+		if r.Hover == nil {
+			for _, resultID := range g.targets("textDocument/hover", id) {
+				hover, err := hoverContents(g.vertices[resultID])
+				if err != nil {
+					return nil, fmt.Errorf("hover result %d: %v", resultID, err)
+				}
+				r.Hover = append(r.Hover, hover...)
+			}
+		}
+		for _, monikerID := range g.targets("moniker", id) {
+			r.Monikers = append(r.Monikers, g.moniker(monikerID))
+		}
+	}
+
+	sortLocations(r.Definitions)
+	sortLocations(r.References)
+	sortLocations(r.Implementations)
+	sort.Strings(r.Monikers)
+	return r, nil
+}
+
+// next returns the vertex the given vertex is linked to with a next edge, or
+// zero if there is none.
+func (g *graph) next(id uint64) uint64 {
+	for _, e := range g.edges["next"][id] {
+		return e.InV
+	}
+	return 0
+}
+
+// targets returns the in vertices of the edges with the given label.
+func (g *graph) targets(label string, id uint64) []uint64 {
+	var ids []uint64
+	for _, e := range g.edges[label][id] {
+		if e.InV != 0 {
+			ids = append(ids, e.InV)
+		}
+		ids = append(ids, e.InVs...)
+	}
+	return ids
+}
+
+// items returns the locations of the ranges in the item edges of a result.
+func (g *graph) items(resultID uint64) []Location {
+	var locations []Location
+	for _, e := range g.edges["item"][resultID] {
+		uri := ""
+		if document, ok := g.vertices[e.Document]; ok {
+			uri = g.relativeURI(document.URI)
+		}
+
+		for _, id := range e.InVs {
+			if v, ok := g.vertices[id]; ok && v.Start != nil && v.End != nil {
+				locations = append(locations, Location{URI: uri, Start: *v.Start, End: *v.End})
+			}
+		}
+	}
+	return locations
+}
+
+// moniker formats a moniker with its package, e.g.
+// `export semanticdb:example/Foo# example@1.0.0`.
+func (g *graph) moniker(id uint64) string {
+	v, ok := g.vertices[id]
+	if !ok {
+		return ""
+	}
+
+	s := fmt.Sprintf("%s %s:%s", v.Kind, v.Scheme, v.Identifier)
+	for _, packageID := range g.targets("packageInformation", id) {
+		if p, ok := g.vertices[packageID]; ok {
+			s += " " + p.Name
+			if p.Version != "" {
+				s += "@" + p.Version
+			}
+		}
+	}
+	return s
+}
+
+// hoverContents returns the marked strings of a hover result. Strings with a
+// language are prefixed with the language in brackets.
+func hoverContents(v *Element) ([]string, error) {
+	if v == nil || len(v.Result) == 0 {
+		return nil, nil
+	}
+
+	var result struct {
+		Contents []json.RawMessage `json:"contents"`
+	}
+	if err := json.Unmarshal(v.Result, &result); err != nil {
+		return nil, err
+	}
+
+	var contents []string
+	for _, raw := range result.Contents {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			contents = append(contents, s)
+			continue
+		}
+
+		var marked struct {
+			Language string `json:"language"`
+			Value    string `json:"value"`
+		}
+		if err := json.Unmarshal(raw, &marked); err != nil {
+			return nil, err
+		}
+		contents = append(contents, fmt.Sprintf("[%s] %s", marked.Language, marked.Value))
+	}
+	return contents, nil
+}
+
+func sortLocations(locations []Location) {
+	sort.Slice(locations, func(i, j int) bool {
+		if locations[i].URI != locations[j].URI {
+			return locations[i].URI < locations[j].URI
+		}
+		if c := comparePos(locations[i].Start, locations[j].Start); c != 0 {
+			return c < 0
+		}
+		return comparePos(locations[i].End, locations[j].End) < 0
+	})
+}
+
+// compareRanges orders ranges by position and ranges with the same position
+// by their results.
+func compareRanges(a, b *Range) int {
+	if c := comparePos(a.Start, b.Start); c != 0 {
+		return c
+	}
+	if c := comparePos(a.End, b.End); c != 0 {
+		return c
+	}
+	return strings.Compare(
+		fmt.Sprint(a.Hover, a.Definitions, a.References, a.Implementations, a.Monikers),
+		fmt.Sprint(b.Hover, b.Definitions, b.References, b.Implementations, b.Monikers),
+	)
+}
+
+func comparePos(a, b protocol.Pos) int {
+	if a.Line != b.Line {
+		return a.Line - b.Line
+	}
+	return a.Character - b.Character
+}
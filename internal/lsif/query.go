@@ -0,0 +1,89 @@
+package lsif
+
+import "github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+
+// Lookup returns the results of the innermost range of a document containing
+// the given position, or nil if the document has no such range. The results
+// of ranges with the same position are combined.
+func Lookup(documents []*Document, uri string, pos protocol.Pos) *Range {
+	var document *Document
+	for _, d := range documents {
+		if d.URI == uri {
+			document = d
+			break
+		}
+	}
+	if document == nil {
+		return nil
+	}
+
+	var found *Range
+	for _, r := range document.Ranges {
+		if !r.contains(pos) {
+			continue
+		}
+
+		switch {
+		case found == nil || found.contains(r.Start) && found.contains(r.End) && !r.hasSpan(found):
+			found = &Range{Start: r.Start, End: r.End}
+			found.add(r)
+		case r.hasSpan(found):
+			found.add(r)
+		}
+	}
+	if found == nil {
+		return nil
+	}
+
+	found.Definitions = uniqueLocations(found.Definitions)
+	found.References = uniqueLocations(found.References)
+	found.Implementations = uniqueLocations(found.Implementations)
+	found.Hover = uniqueStrings(found.Hover)
+	found.Monikers = uniqueStrings(found.Monikers)
+	return found
+}
+
+// contains returns true if the position lies within the range, including
+// its end.
+func (r *Range) contains(pos protocol.Pos) bool {
+	return comparePos(r.Start, pos) <= 0 && comparePos(pos, r.End) <= 0
+}
+
+func (r *Range) hasSpan(other *Range) bool {
+	return r.Start == other.Start && r.End == other.End
+}
+
+// add appends the results of another range.
+func (r *Range) add(other *Range) {
+	r.Definitions = append(r.Definitions, other.Definitions...)
+	r.References = append(r.References, other.References...)
+	r.Implementations = append(r.Implementations, other.Implementations...)
+	r.Hover = append(r.Hover, other.Hover...)
+	r.Monikers = append(r.Monikers, other.Monikers...)
+}
+
+func uniqueLocations(locations []Location) []Location {
+	sortLocations(locations)
+
+	var unique []Location
+	for i, location := range locations {
+		if i == 0 || location != locations[i-1] {
+			unique = append(unique, location)
+		}
+	}
+	return unique
+}
+
+// uniqueStrings removes duplicates while keeping the order of the strings.
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
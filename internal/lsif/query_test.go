@@ -0,0 +1,43 @@
+package lsif
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+func TestLookup(t *testing.T) {
+	pos := func(line, character int) protocol.Pos {
+		return protocol.Pos{Line: line, Character: character}
+	}
+
+	foo := Location{URI: "a.scala", Start: pos(0, 6), End: pos(0, 9)}
+	documents := []*Document{
+		{URI: "a.scala", Ranges: []*Range{
+			{Start: pos(0, 6), End: pos(0, 9), Definitions: []Location{foo}, Hover: []string{"class Foo"}},
+			{Start: pos(2, 0), End: pos(2, 12), Definitions: []Location{{URI: "b.scala", Start: pos(0, 0), End: pos(0, 12)}}},
+			// Duplicate ranges are combined
+			{Start: pos(2, 4), End: pos(2, 7), Definitions: []Location{foo}},
+			{Start: pos(2, 4), End: pos(2, 7), References: []Location{foo}, Hover: []string{"class Foo"}},
+		}},
+	}
+
+	testCases := []struct {
+		uri  string
+		pos  protocol.Pos
+		want *Range
+	}{
+		{"a.scala", pos(0, 9), &Range{Start: pos(0, 6), End: pos(0, 9), Definitions: []Location{foo}, Hover: []string{"class Foo"}}},
+		{"a.scala", pos(2, 5), &Range{Start: pos(2, 4), End: pos(2, 7), Definitions: []Location{foo}, References: []Location{foo}, Hover: []string{"class Foo"}}},
+		{"a.scala", pos(2, 10), &Range{Start: pos(2, 0), End: pos(2, 12), Definitions: []Location{{URI: "b.scala", Start: pos(0, 0), End: pos(0, 12)}}}},
+		{"a.scala", pos(1, 0), nil},
+		{"b.scala", pos(0, 6), nil},
+	}
+
+	for _, testCase := range testCases {
+		if got := Lookup(documents, testCase.uri, testCase.pos); !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("Lookup(%s, %v) = %+v, want %+v", testCase.uri, testCase.pos, got, testCase.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Package lsif reads LSIF dumps written in the JSON lines format.
+package lsif
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+// Element is a single vertex or edge of an LSIF dump. Only the fields read
+// by this package's consumers are decoded.
+type Element struct {
+	ID    uint64 `json:"id"`
+	Type  string `json:"type"`
+	Label string `json:"label"`
+
+	// Edge fields
+	OutV     uint64   `json:"outV,omitempty"`
+	InV      uint64   `json:"inV,omitempty"`
+	InVs     []uint64 `json:"inVs,omitempty"`
+	Document uint64   `json:"document,omitempty"`
+	Property string   `json:"property,omitempty"`
+
+	// Vertex fields
+	URI        string          `json:"uri,omitempty"`
+	LanguageID string          `json:"languageId,omitempty"`
+	Root       string          `json:"projectRoot,omitempty"`
+	Start      *protocol.Pos   `json:"start,omitempty"`
+	End        *protocol.Pos   `json:"end,omitempty"`
+	Result     json.RawMessage `json:"result,omitempty"`
+	Kind       string          `json:"kind,omitempty"`
+	Scheme     string          `json:"scheme,omitempty"`
+	Identifier string          `json:"identifier,omitempty"`
+	Name       string          `json:"name,omitempty"`
+	Manager    string          `json:"manager,omitempty"`
+	Version    string          `json:"version,omitempty"`
+}
+
+// Element types.
+const (
+	TypeVertex = "vertex"
+	TypeEdge   = "edge"
+)
+
+// IsVertex returns true if the element is a vertex.
+func (e *Element) IsVertex() bool {
+	return e.Type == TypeVertex
+}
+
+// IsEdge returns true if the element is an edge.
+func (e *Element) IsEdge() bool {
+	return e.Type == TypeEdge
+}
+
+// maxLineSize is the maximum size of a single element in the dump.
+const maxLineSize = 64 * 1024 * 1024
+
+// Read decodes all elements of the LSIF dump in the given reader.
+func Read(r io.Reader) ([]*Element, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	var elements []*Element
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		element := &Element{}
+		if err := json.Unmarshal(scanner.Bytes(), element); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		elements = append(elements, element)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return elements, nil
+}
@@ -0,0 +1,249 @@
+// Package lsp implements a read-only language server answering navigation
+// requests from SemanticDB documents.
+package lsp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/log"
+	"github.com/sourcegraph/lsif-semanticdb/internal/navigation"
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+// Server answers LSP requests using a navigation index.
+type Server struct {
+	index      *navigation.Index
+	sourceRoot string
+	version    string
+	logger     log.Logger
+	conn       *conn
+	shutdown   bool
+}
+
+// NewServer creates a new Server. Document URIs in the index are resolved
+// relative to the given absolute source root. Requests are logged to logger
+// at debug level.
+func NewServer(index *navigation.Index, sourceRoot, version string, logger log.Logger) *Server {
+	return &Server{
+		index:      index,
+		sourceRoot: sourceRoot,
+		version:    version,
+		logger:     logger,
+	}
+}
+
+// Serve reads requests from r and writes responses to w until the client
+// sends an exit notification or closes the input.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+
+	for {
+		req, err := s.conn.read()
+		if err == io.EOF {
+			return nil
+		}
+		if rerr, ok := err.(*responseError); ok {
+			if err := s.conn.replyError(nil, rerr); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if req.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("exit before shutdown")
+			}
+			return nil
+		}
+
+		result, rerr := s.handle(req)
+		if req.ID == nil {
+			// Notifications have no response
+			continue
+		}
+
+		if rerr != nil {
+			err = s.conn.replyError(req.ID, rerr)
+		} else {
+			err = s.conn.reply(req.ID, result)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(req *request) (interface{}, *responseError) {
+	s.logger.Debug("Handling request", log.F("method", req.Method), log.F("params", string(req.Params)))
+
+	switch req.Method {
+	case "initialize":
+		return initializeResult{
+			Capabilities: serverCapabilities{
+				DefinitionProvider:     true,
+				ReferencesProvider:     true,
+				HoverProvider:          true,
+				DocumentSymbolProvider: true,
+			},
+			ServerInfo: serverInfo{Name: "lsif-semanticdb", Version: s.version},
+		}, nil
+
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+
+	case "textDocument/definition":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		uri, ok := s.documentURI(params.TextDocument.URI)
+		if !ok {
+			return []location{}, nil
+		}
+		return s.locations(s.index.Definition(uri, params.Position.Line, params.Position.Character)), nil
+
+	case "textDocument/references":
+		var params referenceParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		uri, ok := s.documentURI(params.TextDocument.URI)
+		if !ok {
+			return []location{}, nil
+		}
+		return s.locations(s.index.References(uri, params.Position.Line, params.Position.Character, params.Context.IncludeDeclaration)), nil
+
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		uri, ok := s.documentURI(params.TextDocument.URI)
+		if !ok {
+			return nil, nil
+		}
+
+		h, ok := s.index.Hover(uri, params.Position.Line, params.Position.Character)
+		if !ok {
+			return nil, nil
+		}
+
+		value := "```" + h.Language + "\n" + h.Value + "\n```"
+		if h.Documentation != "" {
+			value += "\n\n---\n\n" + h.Documentation
+		}
+
+		r := convertRange(h.Range)
+		return hover{
+			Contents: markupContent{Kind: "markdown", Value: value},
+			Range:    &r,
+		}, nil
+
+	case "textDocument/documentSymbol":
+		var params documentSymbolParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		uri, ok := s.documentURI(params.TextDocument.URI)
+		if !ok {
+			return []symbolInformation{}, nil
+		}
+
+		symbols := []symbolInformation{}
+		for _, symbol := range s.index.Symbols(uri) {
+			symbols = append(symbols, symbolInformation{
+				Name: symbol.Name,
+				Kind: convertKind(symbol.Kind),
+				Location: location{
+					URI:   params.TextDocument.URI,
+					Range: convertRange(symbol.Range),
+				},
+			})
+		}
+		return symbols, nil
+	}
+
+	if strings.HasPrefix(req.Method, "$/") || req.ID == nil {
+		// Optional requests and notifications such as didOpen are ignored
+		return nil, nil
+	}
+
+	return nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not supported: %s", req.Method)}
+}
+
+func unmarshalParams(req *request, v interface{}) *responseError {
+	if err := json.Unmarshal(req.Params, v); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+// documentURI converts a file URI into a SemanticDB document URI.
+func (s *Server) documentURI(fileURI string) (string, bool) {
+	u, err := url.Parse(fileURI)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+
+	rel, err := filepath.Rel(s.sourceRoot, filepath.FromSlash(u.Path))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+
+	return filepath.ToSlash(rel), true
+}
+
+// fileURI converts a SemanticDB document URI into a file URI.
+func (s *Server) fileURI(uri string) string {
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(filepath.Join(s.sourceRoot, filepath.FromSlash(uri)))}
+	return u.String()
+}
+
+func (s *Server) locations(locations []navigation.Location) []location {
+	result := make([]location, 0, len(locations))
+	for _, l := range locations {
+		result = append(result, location{URI: s.fileURI(l.URI), Range: convertRange(l.Range)})
+	}
+	return result
+}
+
+func convertRange(r *pb.Range) lspRange {
+	return lspRange{
+		Start: position{Line: int(r.GetStartLine()), Character: int(r.GetStartCharacter())},
+		End:   position{Line: int(r.GetEndLine()), Character: int(r.GetEndCharacter())},
+	}
+}
+
+func convertKind(kind pb.SymbolInformation_Kind) int {
+	switch kind {
+	case pb.SymbolInformation_PACKAGE, pb.SymbolInformation_PACKAGE_OBJECT:
+		return symbolKindPackage
+	case pb.SymbolInformation_CLASS, pb.SymbolInformation_TRAIT, pb.SymbolInformation_TYPE:
+		return symbolKindClass
+	case pb.SymbolInformation_INTERFACE:
+		return symbolKindInterface
+	case pb.SymbolInformation_OBJECT:
+		return symbolKindObject
+	case pb.SymbolInformation_METHOD, pb.SymbolInformation_MACRO:
+		return symbolKindMethod
+	case pb.SymbolInformation_CONSTRUCTOR:
+		return symbolKindConstructor
+	case pb.SymbolInformation_FIELD:
+		return symbolKindField
+	default:
+		return symbolKindVariable
+	}
+}
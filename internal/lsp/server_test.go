@@ -0,0 +1,180 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/log"
+	"github.com/sourcegraph/lsif-semanticdb/internal/navigation"
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+func testIndex() *navigation.Index {
+	return navigation.NewIndex([]*pb.TextDocument{
+		{
+			Uri:      "a/A.scala",
+			Language: pb.Language_SCALA,
+			Symbols: []*pb.SymbolInformation{
+				{Symbol: "a/A#", Kind: pb.SymbolInformation_CLASS, DisplayName: "A"},
+				{Symbol: "a/A#foo().", Kind: pb.SymbolInformation_METHOD, DisplayName: "foo", Documentation: &pb.Documentation{Message: "Returns foo."}},
+			},
+			Occurrences: []*pb.SymbolOccurrence{
+				{Range: &pb.Range{StartLine: 0, StartCharacter: 6, EndLine: 0, EndCharacter: 7}, Symbol: "a/A#", Role: pb.SymbolOccurrence_DEFINITION},
+				{Range: &pb.Range{StartLine: 1, StartCharacter: 6, EndLine: 1, EndCharacter: 9}, Symbol: "a/A#foo().", Role: pb.SymbolOccurrence_DEFINITION},
+			},
+		},
+		{
+			Uri:      "..b/B.scala",
+			Language: pb.Language_SCALA,
+			Occurrences: []*pb.SymbolOccurrence{
+				{Range: &pb.Range{StartLine: 2, StartCharacter: 4, EndLine: 2, EndCharacter: 7}, Symbol: "a/A#foo().", Role: pb.SymbolOccurrence_REFERENCE},
+			},
+		},
+	})
+}
+
+// serve sends the given messages to a new server and returns its responses
+// in order.
+func serve(t *testing.T, messages ...string) []string {
+	var in bytes.Buffer
+	for _, message := range messages {
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(message), message)
+	}
+
+	var out bytes.Buffer
+	if err := NewServer(testIndex(), "/src", "1.0", log.Nop).Serve(&in, &out); err != nil {
+		t.Fatalf("Serve: %v", err)
+	}
+
+	var responses []string
+	r := bufio.NewReader(&out)
+	for {
+		var length int
+		if _, err := fmt.Fscanf(r, "Content-Length: %d\r\n\r\n", &length); err != nil {
+			break
+		}
+		body := make([]byte, length)
+		if _, err := io.ReadFull(r, body); err != nil {
+			t.Fatalf("read response: %v", err)
+		}
+		responses = append(responses, string(body))
+	}
+	return responses
+}
+
+func positionParams(uri string, line, character int) string {
+	return fmt.Sprintf(`{"textDocument":{"uri":%q},"position":{"line":%d,"character":%d}}`, uri, line, character)
+}
+
+func TestServer(t *testing.T) {
+	requests := []struct {
+		method string
+		params string
+		want   string
+	}{
+		{
+			"initialize", `{}`,
+			`{"capabilities":{"textDocumentSync":0,"definitionProvider":true,"referencesProvider":true,"hoverProvider":true,"documentSymbolProvider":true},"serverInfo":{"name":"lsif-semanticdb","version":"1.0"}}`,
+		},
+		{
+			"textDocument/definition", positionParams("file:///src/..b/B.scala", 2, 5),
+			`[{"uri":"file:///src/a/A.scala","range":{"start":{"line":1,"character":6},"end":{"line":1,"character":9}}}]`,
+		},
+		{
+			"textDocument/references", strings.TrimSuffix(positionParams("file:///src/a/A.scala", 1, 7), "}") + `,"context":{"includeDeclaration":true}}`,
+			`[{"uri":"file:///src/..b/B.scala","range":{"start":{"line":2,"character":4},"end":{"line":2,"character":7}}},{"uri":"file:///src/a/A.scala","range":{"start":{"line":1,"character":6},"end":{"line":1,"character":9}}}]`,
+		},
+		{
+			"textDocument/hover", positionParams("file:///src/a/A.scala", 1, 7),
+			`{"contents":{"kind":"markdown","value":"` + "```scala\\nfoo\\n```\\n\\n---\\n\\nReturns foo." + `"},"range":{"start":{"line":1,"character":6},"end":{"line":1,"character":9}}}`,
+		},
+		{
+			"textDocument/hover", positionParams("file:///src/a/A.scala", 5, 0),
+			`null`,
+		},
+		{
+			"textDocument/documentSymbol", `{"textDocument":{"uri":"file:///src/a/A.scala"}}`,
+			`[{"name":"A","kind":5,"location":{"uri":"file:///src/a/A.scala","range":{"start":{"line":0,"character":6},"end":{"line":0,"character":7}}}},{"name":"foo","kind":6,"location":{"uri":"file:///src/a/A.scala","range":{"start":{"line":1,"character":6},"end":{"line":1,"character":9}}}}]`,
+		},
+		// Documents outside of the source root are unknown
+		{
+			"textDocument/definition", positionParams("file:///a/A.scala", 1, 7),
+			`[]`,
+		},
+		{
+			"textDocument/definition", positionParams("file:///src/../a/A.scala", 1, 7),
+			`[]`,
+		},
+		{
+			"shutdown", `null`,
+			`null`,
+		},
+	}
+
+	var messages, want []string
+	for id, request := range requests {
+		messages = append(messages, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":%q,"params":%s}`, id, request.method, request.params))
+		want = append(want, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":%s}`, id, request.want))
+	}
+	messages = append(messages, `{"jsonrpc":"2.0","method":"exit"}`)
+
+	got := serve(t, messages...)
+	if len(got) != len(want) {
+		t.Fatalf("got %d responses, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("response to %s:\n got %s\nwant %s", requests[i].method, got[i], want[i])
+		}
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	got := serve(t,
+		`{"jsonrpc":"2.0","id":1,"method":"workspace/symbol","params":{}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"textDocument/definition","params":[]}`,
+		`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{}}`,
+		`not json`,
+		`{"jsonrpc":"2.0","id":3,"method":"shutdown"}`,
+		`{"jsonrpc":"2.0","method":"exit"}`,
+	)
+
+	codes := []int{codeMethodNotFound, codeInvalidParams, codeParseError}
+	if len(got) != len(codes)+1 {
+		t.Fatalf("got %d responses, want %d: %v", len(got), len(codes)+1, got)
+	}
+	for i, code := range codes {
+		var resp response
+		if err := json.Unmarshal([]byte(got[i]), &resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Error == nil || resp.Error.Code != code {
+			t.Errorf("response %d: got %s, want error code %d", i, got[i], code)
+		}
+	}
+}
+
+func TestExitBeforeShutdown(t *testing.T) {
+	message := `{"jsonrpc":"2.0","method":"exit"}`
+	in := strings.NewReader(fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(message), message))
+
+	if err := NewServer(testIndex(), "/src", "", log.Nop).Serve(in, &bytes.Buffer{}); err == nil {
+		t.Errorf("expected an error for exit before shutdown")
+	}
+}
+
+func TestServerContentLength(t *testing.T) {
+	for _, length := range []int{-1, maxContentLength + 1} {
+		in := strings.NewReader(fmt.Sprintf("Content-Length: %d\r\n\r\n{}", length))
+
+		err := NewServer(testIndex(), "/src", "", log.Nop).Serve(in, &bytes.Buffer{})
+		if err == nil || !strings.Contains(err.Error(), "invalid Content-Length") {
+			t.Errorf("Content-Length %d: got error %v, want invalid Content-Length", length, err)
+		}
+	}
+}
@@ -0,0 +1,326 @@
+// Package navigation answers code navigation queries directly from
+// SemanticDB documents, resolving symbols the same way the indexer does.
+package navigation
+
+import (
+	"sort"
+	"strings"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+// Location is a range within a document. The URI is the document URI as
+// found in SemanticDB, relative to the source root.
+type Location struct {
+	URI   string
+	Range *pb.Range
+}
+
+// Hover describes the symbol under a position. Documentation is converted to
+// Markdown from the format of the SemanticDB documentation and may be empty.
+type Hover struct {
+	Language      string
+	Value         string
+	Documentation string
+	Range         *pb.Range
+}
+
+// Symbol is a definition within a document.
+type Symbol struct {
+	Symbol string
+	Name   string
+	Kind   pb.SymbolInformation_Kind
+	Range  *pb.Range
+}
+
+// Index holds the documents and symbol tables needed to answer queries.
+type Index struct {
+	documents map[string]*document // Keys: document uri
+	defs      map[string][]*def    // Keys: global symbol
+	refs      map[string][]Location
+	resolver  *semanticdb.Resolver
+}
+
+type document struct {
+	document  *pb.TextDocument
+	dialect   semanticdb.Dialect
+	symbols   map[string]*pb.SymbolInformation
+	localDefs map[string][]*def
+	localRefs map[string][]Location
+}
+
+type def struct {
+	symbol   string
+	location Location
+	document *document
+}
+
+// Load reads all SemanticDB files in the given directories into a new Index.
+func Load(dirs []string) (*Index, error) {
+	var documents []*pb.TextDocument
+	err := semanticdb.Walk(dirs, func(path string, textDocuments *pb.TextDocuments) error {
+		documents = append(documents, textDocuments.GetDocuments()...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIndex(documents), nil
+}
+
+// NewIndex creates an Index of the given documents. Like the indexer, it
+// skips documents without a uri and all but the first document of a uri.
+func NewIndex(documents []*pb.TextDocument) *Index {
+	x := &Index{
+		documents: map[string]*document{},
+		defs:      map[string][]*def{},
+		refs:      map[string][]Location{},
+		resolver:  semanticdb.NewResolver(),
+	}
+
+	for _, d := range documents {
+		if _, ok := x.documents[d.GetUri()]; ok || d.GetUri() == "" {
+			continue
+		}
+		x.add(d)
+	}
+
+	x.link()
+	return x
+}
+
+// add registers the definitions of a document.
+func (x *Index) add(d *pb.TextDocument) {
+	doc := &document{
+		document:  d,
+		dialect:   semanticdb.DialectOf(d),
+		symbols:   map[string]*pb.SymbolInformation{},
+		localDefs: map[string][]*def{},
+		localRefs: map[string][]Location{},
+	}
+	for _, symbol := range d.GetSymbols() {
+		doc.symbols[symbol.GetSymbol()] = symbol
+	}
+
+	for _, occurrence := range d.GetOccurrences() {
+		if occurrence.GetRole() != pb.SymbolOccurrence_DEFINITION {
+			continue
+		}
+
+		key := occurrence.GetSymbol()
+		def := &def{
+			symbol:   key,
+			location: Location{URI: d.GetUri(), Range: occurrence.GetRange()},
+			document: doc,
+		}
+
+		if semanticdb.IsLocal(key) {
+			doc.localDefs[key] = append(doc.localDefs[key], def)
+		} else {
+			x.defs[key] = append(x.defs[key], def)
+		}
+	}
+
+	x.documents[d.GetUri()] = doc
+	x.resolver.AddDocument(d)
+}
+
+// link resolves all reference occurrences once every definition is known.
+func (x *Index) link() {
+	for uri, doc := range x.documents {
+		for _, occurrence := range doc.document.GetOccurrences() {
+			if occurrence.GetRole() != pb.SymbolOccurrence_REFERENCE {
+				continue
+			}
+
+			key, isLocal, ok := x.resolve(doc, occurrence.GetSymbol())
+			if !ok {
+				continue
+			}
+
+			location := Location{URI: uri, Range: occurrence.GetRange()}
+			if isLocal {
+				doc.localRefs[key] = append(doc.localRefs[key], location)
+			} else {
+				x.refs[key] = append(x.refs[key], location)
+			}
+		}
+	}
+}
+
+// resolve returns the key of the definition the symbol refers to, resolving
+// it the same way the indexer does.
+func (x *Index) resolve(doc *document, symbol string) (key string, isLocal bool, ok bool) {
+	return x.resolver.Definition(doc.document.GetUri(), doc.dialect, symbol)
+}
+
+// occurrenceAt returns the innermost occurrence containing the position.
+func (x *Index) occurrenceAt(uri string, line, character int) (*document, *pb.SymbolOccurrence) {
+	doc, ok := x.documents[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	var found *pb.SymbolOccurrence
+	for _, occurrence := range doc.document.GetOccurrences() {
+		r := occurrence.GetRange()
+		if r == nil || !contains(r, line, character) {
+			continue
+		}
+		if found == nil || within(r, found.GetRange()) {
+			found = occurrence
+		}
+	}
+
+	return doc, found
+}
+
+// within returns true if the inner range lies within the outer range.
+func within(inner, outer *pb.Range) bool {
+	return contains(outer, int(inner.StartLine), int(inner.StartCharacter)) &&
+		contains(outer, int(inner.EndLine), int(inner.EndCharacter))
+}
+
+func contains(r *pb.Range, line, character int) bool {
+	if line < int(r.StartLine) || line > int(r.EndLine) {
+		return false
+	}
+	if line == int(r.StartLine) && character < int(r.StartCharacter) {
+		return false
+	}
+	if line == int(r.EndLine) && character > int(r.EndCharacter) {
+		return false
+	}
+	return true
+}
+
+// lookup resolves the occurrence at the given position to its definitions.
+func (x *Index) lookup(uri string, line, character int) (*document, *pb.SymbolOccurrence, []*def, string, bool) {
+	doc, occurrence := x.occurrenceAt(uri, line, character)
+	if occurrence == nil {
+		return nil, nil, nil, "", false
+	}
+
+	key, isLocal, ok := x.resolve(doc, occurrence.GetSymbol())
+	if !ok {
+		return doc, occurrence, nil, "", false
+	}
+
+	if isLocal {
+		return doc, occurrence, doc.localDefs[key], key, true
+	}
+	return doc, occurrence, x.defs[key], key, false
+}
+
+// Definition returns the definitions of the symbol at the given position. A
+// symbol defined by several documents has several definitions.
+func (x *Index) Definition(uri string, line, character int) []Location {
+	_, _, defs, _, _ := x.lookup(uri, line, character)
+
+	var locations []Location
+	for _, def := range defs {
+		locations = append(locations, def.location)
+	}
+
+	sortLocations(locations)
+	return locations
+}
+
+// References returns the references to the symbol at the given position,
+// optionally including its definition.
+func (x *Index) References(uri string, line, character int, includeDeclaration bool) []Location {
+	doc, _, defs, key, isLocal := x.lookup(uri, line, character)
+	if len(defs) == 0 {
+		return nil
+	}
+
+	var locations []Location
+	if includeDeclaration {
+		for _, def := range defs {
+			locations = append(locations, def.location)
+		}
+	}
+
+	if isLocal {
+		locations = append(locations, doc.localRefs[key]...)
+	} else {
+		locations = append(locations, x.refs[key]...)
+	}
+
+	sortLocations(locations)
+	return locations
+}
+
+// Hover returns the hover text of the symbol at the given position.
+func (x *Index) Hover(uri string, line, character int) (*Hover, bool) {
+	_, occurrence, defs, _, _ := x.lookup(uri, line, character)
+	if len(defs) == 0 {
+		return nil, false
+	}
+	def := defs[0]
+
+	symbol := def.document.symbols[def.symbol]
+
+	var language string
+	if def.document.document.GetLanguage() != pb.Language_UNKNOWN_LANGUAGE {
+		language = strings.ToLower(def.document.document.GetLanguage().String())
+	}
+
+	return &Hover{
+		Language:      language,
+		Value:         semanticdb.HoverText(symbol, def.document.dialect, def.document.lookup),
+		Documentation: semanticdb.DocumentationMarkdown(symbol.GetDocumentation()),
+		Range:         occurrence.GetRange(),
+	}, true
+}
+
+// Symbols returns the global definitions of a document in source order.
+func (x *Index) Symbols(uri string) []Symbol {
+	doc, ok := x.documents[uri]
+	if !ok {
+		return nil
+	}
+
+	var symbols []Symbol
+	for _, occurrence := range doc.document.GetOccurrences() {
+		key := occurrence.GetSymbol()
+		if occurrence.GetRole() != pb.SymbolOccurrence_DEFINITION || semanticdb.IsLocal(key) {
+			continue
+		}
+
+		info := doc.symbols[key]
+		switch info.GetKind() {
+		case pb.SymbolInformation_PARAMETER, pb.SymbolInformation_TYPE_PARAMETER, pb.SymbolInformation_SELF_PARAMETER:
+			continue
+		}
+
+		symbols = append(symbols, Symbol{
+			Symbol: key,
+			Name:   info.GetDisplayName(),
+			Kind:   info.GetKind(),
+			Range:  occurrence.GetRange(),
+		})
+	}
+
+	return symbols
+}
+
+// lookup returns the symbol information of a symbol of the document.
+func (d *document) lookup(symbol string) *pb.SymbolInformation {
+	return d.symbols[symbol]
+}
+
+func sortLocations(locations []Location) {
+	sort.SliceStable(locations, func(i, j int) bool {
+		a, b := locations[i], locations[j]
+		if a.URI != b.URI {
+			return a.URI < b.URI
+		}
+		if a.Range.GetStartLine() != b.Range.GetStartLine() {
+			return a.Range.GetStartLine() < b.Range.GetStartLine()
+		}
+		return a.Range.GetStartCharacter() < b.Range.GetStartCharacter()
+	})
+}
@@ -0,0 +1,167 @@
+package navigation
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+func newRange(line, startCharacter, endCharacter int32) *pb.Range {
+	return &pb.Range{StartLine: line, StartCharacter: startCharacter, EndLine: line, EndCharacter: endCharacter}
+}
+
+func occurrence(r *pb.Range, symbol string, role pb.SymbolOccurrence_Role) *pb.SymbolOccurrence {
+	return &pb.SymbolOccurrence{Range: r, Symbol: symbol, Role: role}
+}
+
+// testDocuments returns two documents where b/B.scala uses the class and
+// method of a/A.scala, and c/C.scala defines the same class again.
+func testDocuments() []*pb.TextDocument {
+	const def, ref = pb.SymbolOccurrence_DEFINITION, pb.SymbolOccurrence_REFERENCE
+
+	return []*pb.TextDocument{
+		{
+			Uri:      "a/A.scala",
+			Language: pb.Language_SCALA,
+			Symbols: []*pb.SymbolInformation{
+				{Symbol: "a/A#", Kind: pb.SymbolInformation_CLASS, DisplayName: "A"},
+				{
+					Symbol:        "a/A#foo().",
+					Kind:          pb.SymbolInformation_METHOD,
+					DisplayName:   "foo",
+					Documentation: &pb.Documentation{Message: "Returns foo."},
+				},
+				{Symbol: "a/A#foo().(x)", Kind: pb.SymbolInformation_PARAMETER, DisplayName: "x"},
+				{Symbol: "local0", Kind: pb.SymbolInformation_LOCAL, DisplayName: "y"},
+			},
+			Occurrences: []*pb.SymbolOccurrence{
+				occurrence(newRange(0, 6, 7), "a/A#", def),
+				occurrence(newRange(1, 6, 9), "a/A#foo().", def),
+				occurrence(newRange(1, 10, 11), "a/A#foo().(x)", def),
+				occurrence(newRange(2, 8, 9), "local0", def),
+				occurrence(newRange(3, 2, 3), "local0", ref),
+				occurrence(newRange(3, 6, 9), "a/A#foo().", ref),
+			},
+		},
+		{
+			Uri:      "b/B.scala",
+			Language: pb.Language_SCALA,
+			Occurrences: []*pb.SymbolOccurrence{
+				occurrence(newRange(0, 10, 11), "a/A#", ref),
+				// The enclosing range of the selection a.foo
+				occurrence(newRange(1, 0, 5), "a/A#foo().", ref),
+				occurrence(newRange(1, 2, 5), "a/A#foo().", ref),
+				// A local of another document
+				occurrence(newRange(2, 0, 1), "local0", ref),
+				occurrence(newRange(3, 0, 1), "c/Missing#", ref),
+			},
+		},
+		{
+			Uri:      "c/C.scala",
+			Language: pb.Language_SCALA,
+			Occurrences: []*pb.SymbolOccurrence{
+				occurrence(newRange(0, 6, 7), "a/A#", def),
+			},
+		},
+		// Documents without uri and duplicate documents are skipped
+		{
+			Occurrences: []*pb.SymbolOccurrence{
+				occurrence(newRange(0, 0, 1), "a/A#", def),
+			},
+		},
+		{
+			Uri: "c/C.scala",
+			Occurrences: []*pb.SymbolOccurrence{
+				occurrence(newRange(5, 0, 1), "a/A#", def),
+			},
+		},
+	}
+}
+
+func TestDefinition(t *testing.T) {
+	x := NewIndex(testDocuments())
+
+	testCases := []struct {
+		uri             string
+		line, character int
+		want            []Location
+	}{
+		{"b/B.scala", 0, 10, []Location{{"a/A.scala", newRange(0, 6, 7)}, {"c/C.scala", newRange(0, 6, 7)}}},
+		{"b/B.scala", 1, 3, []Location{{"a/A.scala", newRange(1, 6, 9)}}},
+		{"a/A.scala", 3, 2, []Location{{"a/A.scala", newRange(2, 8, 9)}}},
+		{"a/A.scala", 1, 7, []Location{{"a/A.scala", newRange(1, 6, 9)}}},
+		{"b/B.scala", 2, 0, nil},
+		{"b/B.scala", 3, 0, nil},
+		{"b/B.scala", 9, 0, nil},
+		{"d/D.scala", 0, 0, nil},
+	}
+
+	for _, testCase := range testCases {
+		if got := x.Definition(testCase.uri, testCase.line, testCase.character); !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("Definition(%s, %d, %d) = %v, want %v", testCase.uri, testCase.line, testCase.character, got, testCase.want)
+		}
+	}
+}
+
+func TestReferences(t *testing.T) {
+	x := NewIndex(testDocuments())
+
+	refs := []Location{
+		{"a/A.scala", newRange(3, 6, 9)},
+		{"b/B.scala", newRange(1, 0, 5)},
+		{"b/B.scala", newRange(1, 2, 5)},
+	}
+	if got := x.References("a/A.scala", 1, 6, false); !reflect.DeepEqual(got, refs) {
+		t.Errorf("References without declaration = %v, want %v", got, refs)
+	}
+
+	withDeclaration := append([]Location{{"a/A.scala", newRange(1, 6, 9)}}, refs...)
+	if got := x.References("b/B.scala", 1, 4, true); !reflect.DeepEqual(got, withDeclaration) {
+		t.Errorf("References with declaration = %v, want %v", got, withDeclaration)
+	}
+
+	locals := []Location{{"a/A.scala", newRange(3, 2, 3)}}
+	if got := x.References("a/A.scala", 2, 8, false); !reflect.DeepEqual(got, locals) {
+		t.Errorf("References of local = %v, want %v", got, locals)
+	}
+
+	if got := x.References("b/B.scala", 3, 0, true); got != nil {
+		t.Errorf("References of unresolved symbol = %v, want nil", got)
+	}
+}
+
+func TestHover(t *testing.T) {
+	x := NewIndex(testDocuments())
+
+	got, ok := x.Hover("b/B.scala", 1, 3)
+	want := &Hover{
+		Language:      "scala",
+		Value:         "foo",
+		Documentation: "Returns foo.",
+		Range:         newRange(1, 2, 5),
+	}
+	if !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("Hover = %+v, %v, want %+v", got, ok, want)
+	}
+
+	if _, ok := x.Hover("b/B.scala", 3, 0); ok {
+		t.Errorf("expected no hover for an unresolved symbol")
+	}
+}
+
+func TestSymbols(t *testing.T) {
+	x := NewIndex(testDocuments())
+
+	want := []Symbol{
+		{Symbol: "a/A#", Name: "A", Kind: pb.SymbolInformation_CLASS, Range: newRange(0, 6, 7)},
+		{Symbol: "a/A#foo().", Name: "foo", Kind: pb.SymbolInformation_METHOD, Range: newRange(1, 6, 9)},
+	}
+	if got := x.Symbols("a/A.scala"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Symbols = %+v, want %+v", got, want)
+	}
+
+	if got := x.Symbols("d/D.scala"); got != nil {
+		t.Errorf("Symbols of unknown document = %+v, want nil", got)
+	}
+}
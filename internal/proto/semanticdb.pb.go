@@ -1,13 +1,12 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
-// 	protoc        v3.12.4
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
 // source: semanticdb.proto
 
 package scala_meta_internal_semanticdb
 
 import (
-	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,10 +20,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type Schema int32
 
 const (
@@ -208,7 +203,7 @@ func (x SymbolInformation_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SymbolInformation_Kind.Descriptor instead.
 func (SymbolInformation_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{37, 0}
+	return file_semanticdb_proto_rawDescGZIP(), []int{39, 0}
 }
 
 type SymbolInformation_Property int32
@@ -229,26 +224,38 @@ const (
 	SymbolInformation_PRIMARY          SymbolInformation_Property = 8192
 	SymbolInformation_ENUM             SymbolInformation_Property = 16384
 	SymbolInformation_DEFAULT          SymbolInformation_Property = 32768
+	SymbolInformation_GIVEN            SymbolInformation_Property = 65536
+	SymbolInformation_INLINE           SymbolInformation_Property = 131072
+	SymbolInformation_OPEN             SymbolInformation_Property = 262144
+	SymbolInformation_TRANSPARENT      SymbolInformation_Property = 524288
+	SymbolInformation_INFIX            SymbolInformation_Property = 1048576
+	SymbolInformation_OPAQUE           SymbolInformation_Property = 2097152
 )
 
 // Enum value maps for SymbolInformation_Property.
 var (
 	SymbolInformation_Property_name = map[int32]string{
-		0:     "UNKNOWN_PROPERTY",
-		4:     "ABSTRACT",
-		8:     "FINAL",
-		16:    "SEALED",
-		32:    "IMPLICIT",
-		64:    "LAZY",
-		128:   "CASE",
-		256:   "COVARIANT",
-		512:   "CONTRAVARIANT",
-		1024:  "VAL",
-		2048:  "VAR",
-		4096:  "STATIC",
-		8192:  "PRIMARY",
-		16384: "ENUM",
-		32768: "DEFAULT",
+		0:       "UNKNOWN_PROPERTY",
+		4:       "ABSTRACT",
+		8:       "FINAL",
+		16:      "SEALED",
+		32:      "IMPLICIT",
+		64:      "LAZY",
+		128:     "CASE",
+		256:     "COVARIANT",
+		512:     "CONTRAVARIANT",
+		1024:    "VAL",
+		2048:    "VAR",
+		4096:    "STATIC",
+		8192:    "PRIMARY",
+		16384:   "ENUM",
+		32768:   "DEFAULT",
+		65536:   "GIVEN",
+		131072:  "INLINE",
+		262144:  "OPEN",
+		524288:  "TRANSPARENT",
+		1048576: "INFIX",
+		2097152: "OPAQUE",
 	}
 	SymbolInformation_Property_value = map[string]int32{
 		"UNKNOWN_PROPERTY": 0,
@@ -266,6 +273,12 @@ var (
 		"PRIMARY":          8192,
 		"ENUM":             16384,
 		"DEFAULT":          32768,
+		"GIVEN":            65536,
+		"INLINE":           131072,
+		"OPEN":             262144,
+		"TRANSPARENT":      524288,
+		"INFIX":            1048576,
+		"OPAQUE":           2097152,
 	}
 )
 
@@ -293,7 +306,62 @@ func (x SymbolInformation_Property) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SymbolInformation_Property.Descriptor instead.
 func (SymbolInformation_Property) EnumDescriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{37, 1}
+	return file_semanticdb_proto_rawDescGZIP(), []int{39, 1}
+}
+
+type Documentation_Format int32
+
+const (
+	Documentation_HTML     Documentation_Format = 0
+	Documentation_MARKDOWN Documentation_Format = 1
+	Documentation_JAVADOC  Documentation_Format = 2
+	Documentation_SCALADOC Documentation_Format = 3
+	Documentation_KDOC     Documentation_Format = 4
+)
+
+// Enum value maps for Documentation_Format.
+var (
+	Documentation_Format_name = map[int32]string{
+		0: "HTML",
+		1: "MARKDOWN",
+		2: "JAVADOC",
+		3: "SCALADOC",
+		4: "KDOC",
+	}
+	Documentation_Format_value = map[string]int32{
+		"HTML":     0,
+		"MARKDOWN": 1,
+		"JAVADOC":  2,
+		"SCALADOC": 3,
+		"KDOC":     4,
+	}
+)
+
+func (x Documentation_Format) Enum() *Documentation_Format {
+	p := new(Documentation_Format)
+	*p = x
+	return p
+}
+
+func (x Documentation_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Documentation_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_semanticdb_proto_enumTypes[4].Descriptor()
+}
+
+func (Documentation_Format) Type() protoreflect.EnumType {
+	return &file_semanticdb_proto_enumTypes[4]
+}
+
+func (x Documentation_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Documentation_Format.Descriptor instead.
+func (Documentation_Format) EnumDescriptor() ([]byte, []int) {
+	return file_semanticdb_proto_rawDescGZIP(), []int{40, 0}
 }
 
 type SymbolOccurrence_Role int32
@@ -329,11 +397,11 @@ func (x SymbolOccurrence_Role) String() string {
 }
 
 func (SymbolOccurrence_Role) Descriptor() protoreflect.EnumDescriptor {
-	return file_semanticdb_proto_enumTypes[4].Descriptor()
+	return file_semanticdb_proto_enumTypes[5].Descriptor()
 }
 
 func (SymbolOccurrence_Role) Type() protoreflect.EnumType {
-	return &file_semanticdb_proto_enumTypes[4]
+	return &file_semanticdb_proto_enumTypes[5]
 }
 
 func (x SymbolOccurrence_Role) Number() protoreflect.EnumNumber {
@@ -342,7 +410,7 @@ func (x SymbolOccurrence_Role) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SymbolOccurrence_Role.Descriptor instead.
 func (SymbolOccurrence_Role) EnumDescriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{47, 0}
+	return file_semanticdb_proto_rawDescGZIP(), []int{50, 0}
 }
 
 type Diagnostic_Severity int32
@@ -384,11 +452,11 @@ func (x Diagnostic_Severity) String() string {
 }
 
 func (Diagnostic_Severity) Descriptor() protoreflect.EnumDescriptor {
-	return file_semanticdb_proto_enumTypes[5].Descriptor()
+	return file_semanticdb_proto_enumTypes[6].Descriptor()
 }
 
 func (Diagnostic_Severity) Type() protoreflect.EnumType {
-	return &file_semanticdb_proto_enumTypes[5]
+	return &file_semanticdb_proto_enumTypes[6]
 }
 
 func (x Diagnostic_Severity) Number() protoreflect.EnumNumber {
@@ -397,7 +465,7 @@ func (x Diagnostic_Severity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Diagnostic_Severity.Descriptor instead.
 func (Diagnostic_Severity) EnumDescriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{48, 0}
+	return file_semanticdb_proto_rawDescGZIP(), []int{51, 0}
 }
 
 type TextDocuments struct {
@@ -759,6 +827,8 @@ type Type struct {
 	//	*Type_UniversalType
 	//	*Type_ByNameType
 	//	*Type_RepeatedType
+	//	*Type_MatchType
+	//	*Type_LambdaType
 	SealedValue isType_SealedValue `protobuf_oneof:"sealed_value"`
 }
 
@@ -899,6 +969,20 @@ func (x *Type) GetRepeatedType() *RepeatedType {
 	return nil
 }
 
+func (x *Type) GetMatchType() *MatchType {
+	if x, ok := x.GetSealedValue().(*Type_MatchType); ok {
+		return x.MatchType
+	}
+	return nil
+}
+
+func (x *Type) GetLambdaType() *LambdaType {
+	if x, ok := x.GetSealedValue().(*Type_LambdaType); ok {
+		return x.LambdaType
+	}
+	return nil
+}
+
 type isType_SealedValue interface {
 	isType_SealedValue()
 }
@@ -959,6 +1043,14 @@ type Type_RepeatedType struct {
 	RepeatedType *RepeatedType `protobuf:"bytes,14,opt,name=repeated_type,json=repeatedType,proto3,oneof"`
 }
 
+type Type_MatchType struct {
+	MatchType *MatchType `protobuf:"bytes,25,opt,name=match_type,json=matchType,proto3,oneof"`
+}
+
+type Type_LambdaType struct {
+	LambdaType *LambdaType `protobuf:"bytes,26,opt,name=lambda_type,json=lambdaType,proto3,oneof"`
+}
+
 func (*Type_TypeRef) isType_SealedValue() {}
 
 func (*Type_SingleType) isType_SealedValue() {}
@@ -987,6 +1079,10 @@ func (*Type_ByNameType) isType_SealedValue() {}
 
 func (*Type_RepeatedType) isType_SealedValue() {}
 
+func (*Type_MatchType) isType_SealedValue() {}
+
+func (*Type_LambdaType) isType_SealedValue() {}
+
 type TypeRef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1709,6 +1805,116 @@ func (x *RepeatedType) GetTpe() *Type {
 	return nil
 }
 
+type MatchType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scrutinee *Type                 `protobuf:"bytes,1,opt,name=scrutinee,proto3" json:"scrutinee,omitempty"`
+	Cases     []*MatchType_CaseType `protobuf:"bytes,2,rep,name=cases,proto3" json:"cases,omitempty"`
+}
+
+func (x *MatchType) Reset() {
+	*x = MatchType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_semanticdb_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchType) ProtoMessage() {}
+
+func (x *MatchType) ProtoReflect() protoreflect.Message {
+	mi := &file_semanticdb_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchType.ProtoReflect.Descriptor instead.
+func (*MatchType) Descriptor() ([]byte, []int) {
+	return file_semanticdb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MatchType) GetScrutinee() *Type {
+	if x != nil {
+		return x.Scrutinee
+	}
+	return nil
+}
+
+func (x *MatchType) GetCases() []*MatchType_CaseType {
+	if x != nil {
+		return x.Cases
+	}
+	return nil
+}
+
+type LambdaType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parameters *Scope `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	ReturnType *Type  `protobuf:"bytes,2,opt,name=return_type,json=returnType,proto3" json:"return_type,omitempty"`
+}
+
+func (x *LambdaType) Reset() {
+	*x = LambdaType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_semanticdb_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LambdaType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LambdaType) ProtoMessage() {}
+
+func (x *LambdaType) ProtoReflect() protoreflect.Message {
+	mi := &file_semanticdb_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LambdaType.ProtoReflect.Descriptor instead.
+func (*LambdaType) Descriptor() ([]byte, []int) {
+	return file_semanticdb_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LambdaType) GetParameters() *Scope {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *LambdaType) GetReturnType() *Type {
+	if x != nil {
+		return x.ReturnType
+	}
+	return nil
+}
+
 type Constant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1732,7 +1938,7 @@ type Constant struct {
 func (x *Constant) Reset() {
 	*x = Constant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[20]
+		mi := &file_semanticdb_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1745,7 +1951,7 @@ func (x *Constant) String() string {
 func (*Constant) ProtoMessage() {}
 
 func (x *Constant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[20]
+	mi := &file_semanticdb_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,7 +1964,7 @@ func (x *Constant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Constant.ProtoReflect.Descriptor instead.
 func (*Constant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{20}
+	return file_semanticdb_proto_rawDescGZIP(), []int{22}
 }
 
 func (m *Constant) GetSealedValue() isConstant_SealedValue {
@@ -1924,7 +2130,7 @@ type UnitConstant struct {
 func (x *UnitConstant) Reset() {
 	*x = UnitConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[21]
+		mi := &file_semanticdb_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1937,7 +2143,7 @@ func (x *UnitConstant) String() string {
 func (*UnitConstant) ProtoMessage() {}
 
 func (x *UnitConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[21]
+	mi := &file_semanticdb_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1950,7 +2156,7 @@ func (x *UnitConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnitConstant.ProtoReflect.Descriptor instead.
 func (*UnitConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{21}
+	return file_semanticdb_proto_rawDescGZIP(), []int{23}
 }
 
 type BooleanConstant struct {
@@ -1964,7 +2170,7 @@ type BooleanConstant struct {
 func (x *BooleanConstant) Reset() {
 	*x = BooleanConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[22]
+		mi := &file_semanticdb_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1977,7 +2183,7 @@ func (x *BooleanConstant) String() string {
 func (*BooleanConstant) ProtoMessage() {}
 
 func (x *BooleanConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[22]
+	mi := &file_semanticdb_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1990,7 +2196,7 @@ func (x *BooleanConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BooleanConstant.ProtoReflect.Descriptor instead.
 func (*BooleanConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{22}
+	return file_semanticdb_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *BooleanConstant) GetValue() bool {
@@ -2011,7 +2217,7 @@ type ByteConstant struct {
 func (x *ByteConstant) Reset() {
 	*x = ByteConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[23]
+		mi := &file_semanticdb_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2024,7 +2230,7 @@ func (x *ByteConstant) String() string {
 func (*ByteConstant) ProtoMessage() {}
 
 func (x *ByteConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[23]
+	mi := &file_semanticdb_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2037,7 +2243,7 @@ func (x *ByteConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ByteConstant.ProtoReflect.Descriptor instead.
 func (*ByteConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{23}
+	return file_semanticdb_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ByteConstant) GetValue() int32 {
@@ -2058,7 +2264,7 @@ type ShortConstant struct {
 func (x *ShortConstant) Reset() {
 	*x = ShortConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[24]
+		mi := &file_semanticdb_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2071,7 +2277,7 @@ func (x *ShortConstant) String() string {
 func (*ShortConstant) ProtoMessage() {}
 
 func (x *ShortConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[24]
+	mi := &file_semanticdb_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2084,7 +2290,7 @@ func (x *ShortConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShortConstant.ProtoReflect.Descriptor instead.
 func (*ShortConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{24}
+	return file_semanticdb_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ShortConstant) GetValue() int32 {
@@ -2105,7 +2311,7 @@ type CharConstant struct {
 func (x *CharConstant) Reset() {
 	*x = CharConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[25]
+		mi := &file_semanticdb_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2118,7 +2324,7 @@ func (x *CharConstant) String() string {
 func (*CharConstant) ProtoMessage() {}
 
 func (x *CharConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[25]
+	mi := &file_semanticdb_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2131,7 +2337,7 @@ func (x *CharConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CharConstant.ProtoReflect.Descriptor instead.
 func (*CharConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{25}
+	return file_semanticdb_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CharConstant) GetValue() int32 {
@@ -2152,7 +2358,7 @@ type IntConstant struct {
 func (x *IntConstant) Reset() {
 	*x = IntConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[26]
+		mi := &file_semanticdb_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2165,7 +2371,7 @@ func (x *IntConstant) String() string {
 func (*IntConstant) ProtoMessage() {}
 
 func (x *IntConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[26]
+	mi := &file_semanticdb_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,7 +2384,7 @@ func (x *IntConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntConstant.ProtoReflect.Descriptor instead.
 func (*IntConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{26}
+	return file_semanticdb_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *IntConstant) GetValue() int32 {
@@ -2199,7 +2405,7 @@ type LongConstant struct {
 func (x *LongConstant) Reset() {
 	*x = LongConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[27]
+		mi := &file_semanticdb_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2212,7 +2418,7 @@ func (x *LongConstant) String() string {
 func (*LongConstant) ProtoMessage() {}
 
 func (x *LongConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[27]
+	mi := &file_semanticdb_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,7 +2431,7 @@ func (x *LongConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LongConstant.ProtoReflect.Descriptor instead.
 func (*LongConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{27}
+	return file_semanticdb_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *LongConstant) GetValue() int64 {
@@ -2246,7 +2452,7 @@ type FloatConstant struct {
 func (x *FloatConstant) Reset() {
 	*x = FloatConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[28]
+		mi := &file_semanticdb_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2259,7 +2465,7 @@ func (x *FloatConstant) String() string {
 func (*FloatConstant) ProtoMessage() {}
 
 func (x *FloatConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[28]
+	mi := &file_semanticdb_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2272,7 +2478,7 @@ func (x *FloatConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloatConstant.ProtoReflect.Descriptor instead.
 func (*FloatConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{28}
+	return file_semanticdb_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *FloatConstant) GetValue() float32 {
@@ -2293,7 +2499,7 @@ type DoubleConstant struct {
 func (x *DoubleConstant) Reset() {
 	*x = DoubleConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[29]
+		mi := &file_semanticdb_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2306,7 +2512,7 @@ func (x *DoubleConstant) String() string {
 func (*DoubleConstant) ProtoMessage() {}
 
 func (x *DoubleConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[29]
+	mi := &file_semanticdb_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2319,7 +2525,7 @@ func (x *DoubleConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoubleConstant.ProtoReflect.Descriptor instead.
 func (*DoubleConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{29}
+	return file_semanticdb_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DoubleConstant) GetValue() float64 {
@@ -2340,7 +2546,7 @@ type StringConstant struct {
 func (x *StringConstant) Reset() {
 	*x = StringConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[30]
+		mi := &file_semanticdb_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2353,7 +2559,7 @@ func (x *StringConstant) String() string {
 func (*StringConstant) ProtoMessage() {}
 
 func (x *StringConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[30]
+	mi := &file_semanticdb_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2366,7 +2572,7 @@ func (x *StringConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringConstant.ProtoReflect.Descriptor instead.
 func (*StringConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{30}
+	return file_semanticdb_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *StringConstant) GetValue() string {
@@ -2385,7 +2591,7 @@ type NullConstant struct {
 func (x *NullConstant) Reset() {
 	*x = NullConstant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[31]
+		mi := &file_semanticdb_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2398,7 +2604,7 @@ func (x *NullConstant) String() string {
 func (*NullConstant) ProtoMessage() {}
 
 func (x *NullConstant) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[31]
+	mi := &file_semanticdb_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2411,7 +2617,7 @@ func (x *NullConstant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NullConstant.ProtoReflect.Descriptor instead.
 func (*NullConstant) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{31}
+	return file_semanticdb_proto_rawDescGZIP(), []int{33}
 }
 
 type Signature struct {
@@ -2430,7 +2636,7 @@ type Signature struct {
 func (x *Signature) Reset() {
 	*x = Signature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[32]
+		mi := &file_semanticdb_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2443,7 +2649,7 @@ func (x *Signature) String() string {
 func (*Signature) ProtoMessage() {}
 
 func (x *Signature) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[32]
+	mi := &file_semanticdb_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2456,7 +2662,7 @@ func (x *Signature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Signature.ProtoReflect.Descriptor instead.
 func (*Signature) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{32}
+	return file_semanticdb_proto_rawDescGZIP(), []int{34}
 }
 
 func (m *Signature) GetSealedValue() isSignature_SealedValue {
@@ -2536,7 +2742,7 @@ type ClassSignature struct {
 func (x *ClassSignature) Reset() {
 	*x = ClassSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[33]
+		mi := &file_semanticdb_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2549,7 +2755,7 @@ func (x *ClassSignature) String() string {
 func (*ClassSignature) ProtoMessage() {}
 
 func (x *ClassSignature) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[33]
+	mi := &file_semanticdb_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2562,7 +2768,7 @@ func (x *ClassSignature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClassSignature.ProtoReflect.Descriptor instead.
 func (*ClassSignature) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{33}
+	return file_semanticdb_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ClassSignature) GetTypeParameters() *Scope {
@@ -2601,12 +2807,13 @@ type MethodSignature struct {
 	TypeParameters *Scope   `protobuf:"bytes,1,opt,name=type_parameters,json=typeParameters,proto3" json:"type_parameters,omitempty"`
 	ParameterLists []*Scope `protobuf:"bytes,2,rep,name=parameter_lists,json=parameterLists,proto3" json:"parameter_lists,omitempty"`
 	ReturnType     *Type    `protobuf:"bytes,3,opt,name=return_type,json=returnType,proto3" json:"return_type,omitempty"`
+	Throws         []*Type  `protobuf:"bytes,4,rep,name=throws,proto3" json:"throws,omitempty"`
 }
 
 func (x *MethodSignature) Reset() {
 	*x = MethodSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[34]
+		mi := &file_semanticdb_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2619,7 +2826,7 @@ func (x *MethodSignature) String() string {
 func (*MethodSignature) ProtoMessage() {}
 
 func (x *MethodSignature) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[34]
+	mi := &file_semanticdb_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2632,7 +2839,7 @@ func (x *MethodSignature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MethodSignature.ProtoReflect.Descriptor instead.
 func (*MethodSignature) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{34}
+	return file_semanticdb_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *MethodSignature) GetTypeParameters() *Scope {
@@ -2656,6 +2863,13 @@ func (x *MethodSignature) GetReturnType() *Type {
 	return nil
 }
 
+func (x *MethodSignature) GetThrows() []*Type {
+	if x != nil {
+		return x.Throws
+	}
+	return nil
+}
+
 type TypeSignature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2669,7 +2883,7 @@ type TypeSignature struct {
 func (x *TypeSignature) Reset() {
 	*x = TypeSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[35]
+		mi := &file_semanticdb_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2682,7 +2896,7 @@ func (x *TypeSignature) String() string {
 func (*TypeSignature) ProtoMessage() {}
 
 func (x *TypeSignature) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[35]
+	mi := &file_semanticdb_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2695,7 +2909,7 @@ func (x *TypeSignature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeSignature.ProtoReflect.Descriptor instead.
 func (*TypeSignature) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{35}
+	return file_semanticdb_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *TypeSignature) GetTypeParameters() *Scope {
@@ -2730,7 +2944,7 @@ type ValueSignature struct {
 func (x *ValueSignature) Reset() {
 	*x = ValueSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[36]
+		mi := &file_semanticdb_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2743,7 +2957,7 @@ func (x *ValueSignature) String() string {
 func (*ValueSignature) ProtoMessage() {}
 
 func (x *ValueSignature) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[36]
+	mi := &file_semanticdb_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2756,7 +2970,7 @@ func (x *ValueSignature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueSignature.ProtoReflect.Descriptor instead.
 func (*ValueSignature) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{36}
+	return file_semanticdb_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ValueSignature) GetTpe() *Type {
@@ -2771,20 +2985,22 @@ type SymbolInformation struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbol      string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Language    Language               `protobuf:"varint,16,opt,name=language,proto3,enum=scala.meta.internal.semanticdb.Language" json:"language,omitempty"`
-	Kind        SymbolInformation_Kind `protobuf:"varint,3,opt,name=kind,proto3,enum=scala.meta.internal.semanticdb.SymbolInformation_Kind" json:"kind,omitempty"`
-	Properties  int32                  `protobuf:"varint,4,opt,name=properties,proto3" json:"properties,omitempty"`
-	DisplayName string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Signature   *Signature             `protobuf:"bytes,17,opt,name=signature,proto3" json:"signature,omitempty"`
-	Annotations []*Annotation          `protobuf:"bytes,13,rep,name=annotations,proto3" json:"annotations,omitempty"`
-	Access      *Access                `protobuf:"bytes,18,opt,name=access,proto3" json:"access,omitempty"`
+	Symbol            string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Language          Language               `protobuf:"varint,16,opt,name=language,proto3,enum=scala.meta.internal.semanticdb.Language" json:"language,omitempty"`
+	Kind              SymbolInformation_Kind `protobuf:"varint,3,opt,name=kind,proto3,enum=scala.meta.internal.semanticdb.SymbolInformation_Kind" json:"kind,omitempty"`
+	Properties        int32                  `protobuf:"varint,4,opt,name=properties,proto3" json:"properties,omitempty"`
+	DisplayName       string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Signature         *Signature             `protobuf:"bytes,17,opt,name=signature,proto3" json:"signature,omitempty"`
+	Annotations       []*Annotation          `protobuf:"bytes,13,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	Access            *Access                `protobuf:"bytes,18,opt,name=access,proto3" json:"access,omitempty"`
+	OverriddenSymbols []string               `protobuf:"bytes,19,rep,name=overridden_symbols,json=overriddenSymbols,proto3" json:"overridden_symbols,omitempty"`
+	Documentation     *Documentation         `protobuf:"bytes,20,opt,name=documentation,proto3" json:"documentation,omitempty"`
 }
 
 func (x *SymbolInformation) Reset() {
 	*x = SymbolInformation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[37]
+		mi := &file_semanticdb_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2797,7 +3013,7 @@ func (x *SymbolInformation) String() string {
 func (*SymbolInformation) ProtoMessage() {}
 
 func (x *SymbolInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[37]
+	mi := &file_semanticdb_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2810,7 +3026,7 @@ func (x *SymbolInformation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SymbolInformation.ProtoReflect.Descriptor instead.
 func (*SymbolInformation) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{37}
+	return file_semanticdb_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *SymbolInformation) GetSymbol() string {
@@ -2869,18 +3085,87 @@ func (x *SymbolInformation) GetAccess() *Access {
 	return nil
 }
 
-type Annotation struct {
+func (x *SymbolInformation) GetOverriddenSymbols() []string {
+	if x != nil {
+		return x.OverriddenSymbols
+	}
+	return nil
+}
+
+func (x *SymbolInformation) GetDocumentation() *Documentation {
+	if x != nil {
+		return x.Documentation
+	}
+	return nil
+}
+
+type Documentation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tpe *Type `protobuf:"bytes,1,opt,name=tpe,proto3" json:"tpe,omitempty"`
+	Message string               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Format  Documentation_Format `protobuf:"varint,2,opt,name=format,proto3,enum=scala.meta.internal.semanticdb.Documentation_Format" json:"format,omitempty"`
+}
+
+func (x *Documentation) Reset() {
+	*x = Documentation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_semanticdb_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Documentation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Documentation) ProtoMessage() {}
+
+func (x *Documentation) ProtoReflect() protoreflect.Message {
+	mi := &file_semanticdb_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Documentation.ProtoReflect.Descriptor instead.
+func (*Documentation) Descriptor() ([]byte, []int) {
+	return file_semanticdb_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Documentation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Documentation) GetFormat() Documentation_Format {
+	if x != nil {
+		return x.Format
+	}
+	return Documentation_HTML
+}
+
+type Annotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tpe *Type `protobuf:"bytes,1,opt,name=tpe,proto3" json:"tpe,omitempty"`
 }
 
 func (x *Annotation) Reset() {
 	*x = Annotation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[38]
+		mi := &file_semanticdb_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2893,7 +3178,7 @@ func (x *Annotation) String() string {
 func (*Annotation) ProtoMessage() {}
 
 func (x *Annotation) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[38]
+	mi := &file_semanticdb_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2906,7 +3191,7 @@ func (x *Annotation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
 func (*Annotation) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{38}
+	return file_semanticdb_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Annotation) GetTpe() *Type {
@@ -2935,7 +3220,7 @@ type Access struct {
 func (x *Access) Reset() {
 	*x = Access{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[39]
+		mi := &file_semanticdb_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2948,7 +3233,7 @@ func (x *Access) String() string {
 func (*Access) ProtoMessage() {}
 
 func (x *Access) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[39]
+	mi := &file_semanticdb_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2961,7 +3246,7 @@ func (x *Access) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Access.ProtoReflect.Descriptor instead.
 func (*Access) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{39}
+	return file_semanticdb_proto_rawDescGZIP(), []int{42}
 }
 
 func (m *Access) GetSealedValue() isAccess_SealedValue {
@@ -3075,7 +3360,7 @@ type PrivateAccess struct {
 func (x *PrivateAccess) Reset() {
 	*x = PrivateAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[40]
+		mi := &file_semanticdb_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3088,7 +3373,7 @@ func (x *PrivateAccess) String() string {
 func (*PrivateAccess) ProtoMessage() {}
 
 func (x *PrivateAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[40]
+	mi := &file_semanticdb_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3101,7 +3386,7 @@ func (x *PrivateAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateAccess.ProtoReflect.Descriptor instead.
 func (*PrivateAccess) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{40}
+	return file_semanticdb_proto_rawDescGZIP(), []int{43}
 }
 
 type PrivateThisAccess struct {
@@ -3113,7 +3398,7 @@ type PrivateThisAccess struct {
 func (x *PrivateThisAccess) Reset() {
 	*x = PrivateThisAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[41]
+		mi := &file_semanticdb_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3126,7 +3411,7 @@ func (x *PrivateThisAccess) String() string {
 func (*PrivateThisAccess) ProtoMessage() {}
 
 func (x *PrivateThisAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[41]
+	mi := &file_semanticdb_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3139,7 +3424,7 @@ func (x *PrivateThisAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateThisAccess.ProtoReflect.Descriptor instead.
 func (*PrivateThisAccess) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{41}
+	return file_semanticdb_proto_rawDescGZIP(), []int{44}
 }
 
 type PrivateWithinAccess struct {
@@ -3153,7 +3438,7 @@ type PrivateWithinAccess struct {
 func (x *PrivateWithinAccess) Reset() {
 	*x = PrivateWithinAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[42]
+		mi := &file_semanticdb_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3166,7 +3451,7 @@ func (x *PrivateWithinAccess) String() string {
 func (*PrivateWithinAccess) ProtoMessage() {}
 
 func (x *PrivateWithinAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[42]
+	mi := &file_semanticdb_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3179,7 +3464,7 @@ func (x *PrivateWithinAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateWithinAccess.ProtoReflect.Descriptor instead.
 func (*PrivateWithinAccess) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{42}
+	return file_semanticdb_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *PrivateWithinAccess) GetSymbol() string {
@@ -3198,7 +3483,7 @@ type ProtectedAccess struct {
 func (x *ProtectedAccess) Reset() {
 	*x = ProtectedAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[43]
+		mi := &file_semanticdb_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3211,7 +3496,7 @@ func (x *ProtectedAccess) String() string {
 func (*ProtectedAccess) ProtoMessage() {}
 
 func (x *ProtectedAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[43]
+	mi := &file_semanticdb_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3224,7 +3509,7 @@ func (x *ProtectedAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtectedAccess.ProtoReflect.Descriptor instead.
 func (*ProtectedAccess) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{43}
+	return file_semanticdb_proto_rawDescGZIP(), []int{46}
 }
 
 type ProtectedThisAccess struct {
@@ -3236,7 +3521,7 @@ type ProtectedThisAccess struct {
 func (x *ProtectedThisAccess) Reset() {
 	*x = ProtectedThisAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[44]
+		mi := &file_semanticdb_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3249,7 +3534,7 @@ func (x *ProtectedThisAccess) String() string {
 func (*ProtectedThisAccess) ProtoMessage() {}
 
 func (x *ProtectedThisAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[44]
+	mi := &file_semanticdb_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3262,7 +3547,7 @@ func (x *ProtectedThisAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtectedThisAccess.ProtoReflect.Descriptor instead.
 func (*ProtectedThisAccess) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{44}
+	return file_semanticdb_proto_rawDescGZIP(), []int{47}
 }
 
 type ProtectedWithinAccess struct {
@@ -3276,7 +3561,7 @@ type ProtectedWithinAccess struct {
 func (x *ProtectedWithinAccess) Reset() {
 	*x = ProtectedWithinAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[45]
+		mi := &file_semanticdb_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3289,7 +3574,7 @@ func (x *ProtectedWithinAccess) String() string {
 func (*ProtectedWithinAccess) ProtoMessage() {}
 
 func (x *ProtectedWithinAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[45]
+	mi := &file_semanticdb_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3302,7 +3587,7 @@ func (x *ProtectedWithinAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtectedWithinAccess.ProtoReflect.Descriptor instead.
 func (*ProtectedWithinAccess) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{45}
+	return file_semanticdb_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ProtectedWithinAccess) GetSymbol() string {
@@ -3321,7 +3606,7 @@ type PublicAccess struct {
 func (x *PublicAccess) Reset() {
 	*x = PublicAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[46]
+		mi := &file_semanticdb_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3334,7 +3619,7 @@ func (x *PublicAccess) String() string {
 func (*PublicAccess) ProtoMessage() {}
 
 func (x *PublicAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[46]
+	mi := &file_semanticdb_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3347,7 +3632,7 @@ func (x *PublicAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublicAccess.ProtoReflect.Descriptor instead.
 func (*PublicAccess) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{46}
+	return file_semanticdb_proto_rawDescGZIP(), []int{49}
 }
 
 type SymbolOccurrence struct {
@@ -3363,7 +3648,7 @@ type SymbolOccurrence struct {
 func (x *SymbolOccurrence) Reset() {
 	*x = SymbolOccurrence{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[47]
+		mi := &file_semanticdb_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3376,7 +3661,7 @@ func (x *SymbolOccurrence) String() string {
 func (*SymbolOccurrence) ProtoMessage() {}
 
 func (x *SymbolOccurrence) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[47]
+	mi := &file_semanticdb_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3389,7 +3674,7 @@ func (x *SymbolOccurrence) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SymbolOccurrence.ProtoReflect.Descriptor instead.
 func (*SymbolOccurrence) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{47}
+	return file_semanticdb_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *SymbolOccurrence) GetRange() *Range {
@@ -3426,7 +3711,7 @@ type Diagnostic struct {
 func (x *Diagnostic) Reset() {
 	*x = Diagnostic{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[48]
+		mi := &file_semanticdb_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3439,7 +3724,7 @@ func (x *Diagnostic) String() string {
 func (*Diagnostic) ProtoMessage() {}
 
 func (x *Diagnostic) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[48]
+	mi := &file_semanticdb_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3452,7 +3737,7 @@ func (x *Diagnostic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Diagnostic.ProtoReflect.Descriptor instead.
 func (*Diagnostic) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{48}
+	return file_semanticdb_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *Diagnostic) GetRange() *Range {
@@ -3488,7 +3773,7 @@ type Synthetic struct {
 func (x *Synthetic) Reset() {
 	*x = Synthetic{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[49]
+		mi := &file_semanticdb_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3501,7 +3786,7 @@ func (x *Synthetic) String() string {
 func (*Synthetic) ProtoMessage() {}
 
 func (x *Synthetic) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[49]
+	mi := &file_semanticdb_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3514,7 +3799,7 @@ func (x *Synthetic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Synthetic.ProtoReflect.Descriptor instead.
 func (*Synthetic) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{49}
+	return file_semanticdb_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *Synthetic) GetRange() *Range {
@@ -3551,7 +3836,7 @@ type Tree struct {
 func (x *Tree) Reset() {
 	*x = Tree{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[50]
+		mi := &file_semanticdb_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3564,7 +3849,7 @@ func (x *Tree) String() string {
 func (*Tree) ProtoMessage() {}
 
 func (x *Tree) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[50]
+	mi := &file_semanticdb_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3577,7 +3862,7 @@ func (x *Tree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tree.ProtoReflect.Descriptor instead.
 func (*Tree) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{50}
+	return file_semanticdb_proto_rawDescGZIP(), []int{53}
 }
 
 func (m *Tree) GetSealedValue() isTree_SealedValue {
@@ -3707,7 +3992,7 @@ type ApplyTree struct {
 func (x *ApplyTree) Reset() {
 	*x = ApplyTree{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[51]
+		mi := &file_semanticdb_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3720,7 +4005,7 @@ func (x *ApplyTree) String() string {
 func (*ApplyTree) ProtoMessage() {}
 
 func (x *ApplyTree) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[51]
+	mi := &file_semanticdb_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3733,7 +4018,7 @@ func (x *ApplyTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyTree.ProtoReflect.Descriptor instead.
 func (*ApplyTree) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{51}
+	return file_semanticdb_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ApplyTree) GetFunction() *Tree {
@@ -3762,7 +4047,7 @@ type FunctionTree struct {
 func (x *FunctionTree) Reset() {
 	*x = FunctionTree{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[52]
+		mi := &file_semanticdb_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3775,7 +4060,7 @@ func (x *FunctionTree) String() string {
 func (*FunctionTree) ProtoMessage() {}
 
 func (x *FunctionTree) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[52]
+	mi := &file_semanticdb_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3788,7 +4073,7 @@ func (x *FunctionTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FunctionTree.ProtoReflect.Descriptor instead.
 func (*FunctionTree) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{52}
+	return file_semanticdb_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *FunctionTree) GetParameters() []*IdTree {
@@ -3816,7 +4101,7 @@ type IdTree struct {
 func (x *IdTree) Reset() {
 	*x = IdTree{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[53]
+		mi := &file_semanticdb_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3829,7 +4114,7 @@ func (x *IdTree) String() string {
 func (*IdTree) ProtoMessage() {}
 
 func (x *IdTree) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[53]
+	mi := &file_semanticdb_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3842,7 +4127,7 @@ func (x *IdTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdTree.ProtoReflect.Descriptor instead.
 func (*IdTree) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{53}
+	return file_semanticdb_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *IdTree) GetSymbol() string {
@@ -3863,7 +4148,7 @@ type LiteralTree struct {
 func (x *LiteralTree) Reset() {
 	*x = LiteralTree{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[54]
+		mi := &file_semanticdb_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3876,7 +4161,7 @@ func (x *LiteralTree) String() string {
 func (*LiteralTree) ProtoMessage() {}
 
 func (x *LiteralTree) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[54]
+	mi := &file_semanticdb_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3889,7 +4174,7 @@ func (x *LiteralTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LiteralTree.ProtoReflect.Descriptor instead.
 func (*LiteralTree) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{54}
+	return file_semanticdb_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *LiteralTree) GetConstant() *Constant {
@@ -3911,7 +4196,7 @@ type MacroExpansionTree struct {
 func (x *MacroExpansionTree) Reset() {
 	*x = MacroExpansionTree{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[55]
+		mi := &file_semanticdb_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3924,7 +4209,7 @@ func (x *MacroExpansionTree) String() string {
 func (*MacroExpansionTree) ProtoMessage() {}
 
 func (x *MacroExpansionTree) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[55]
+	mi := &file_semanticdb_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3937,7 +4222,7 @@ func (x *MacroExpansionTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MacroExpansionTree.ProtoReflect.Descriptor instead.
 func (*MacroExpansionTree) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{55}
+	return file_semanticdb_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *MacroExpansionTree) GetBeforeExpansion() *Tree {
@@ -3965,7 +4250,7 @@ type OriginalTree struct {
 func (x *OriginalTree) Reset() {
 	*x = OriginalTree{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[56]
+		mi := &file_semanticdb_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3978,7 +4263,7 @@ func (x *OriginalTree) String() string {
 func (*OriginalTree) ProtoMessage() {}
 
 func (x *OriginalTree) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[56]
+	mi := &file_semanticdb_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3991,7 +4276,7 @@ func (x *OriginalTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OriginalTree.ProtoReflect.Descriptor instead.
 func (*OriginalTree) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{56}
+	return file_semanticdb_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *OriginalTree) GetRange() *Range {
@@ -4013,7 +4298,7 @@ type SelectTree struct {
 func (x *SelectTree) Reset() {
 	*x = SelectTree{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[57]
+		mi := &file_semanticdb_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4026,7 +4311,7 @@ func (x *SelectTree) String() string {
 func (*SelectTree) ProtoMessage() {}
 
 func (x *SelectTree) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[57]
+	mi := &file_semanticdb_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4039,7 +4324,7 @@ func (x *SelectTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelectTree.ProtoReflect.Descriptor instead.
 func (*SelectTree) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{57}
+	return file_semanticdb_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *SelectTree) GetQualifier() *Tree {
@@ -4068,7 +4353,7 @@ type TypeApplyTree struct {
 func (x *TypeApplyTree) Reset() {
 	*x = TypeApplyTree{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_semanticdb_proto_msgTypes[58]
+		mi := &file_semanticdb_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4081,7 +4366,7 @@ func (x *TypeApplyTree) String() string {
 func (*TypeApplyTree) ProtoMessage() {}
 
 func (x *TypeApplyTree) ProtoReflect() protoreflect.Message {
-	mi := &file_semanticdb_proto_msgTypes[58]
+	mi := &file_semanticdb_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4094,7 +4379,7 @@ func (x *TypeApplyTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeApplyTree.ProtoReflect.Descriptor instead.
 func (*TypeApplyTree) Descriptor() ([]byte, []int) {
-	return file_semanticdb_proto_rawDescGZIP(), []int{58}
+	return file_semanticdb_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *TypeApplyTree) GetFunction() *Tree {
@@ -4111,6 +4396,61 @@ func (x *TypeApplyTree) GetTypeArguments() []*Type {
 	return nil
 }
 
+type MatchType_CaseType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  *Type `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Body *Type `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *MatchType_CaseType) Reset() {
+	*x = MatchType_CaseType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_semanticdb_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchType_CaseType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchType_CaseType) ProtoMessage() {}
+
+func (x *MatchType_CaseType) ProtoReflect() protoreflect.Message {
+	mi := &file_semanticdb_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchType_CaseType.ProtoReflect.Descriptor instead.
+func (*MatchType_CaseType) Descriptor() ([]byte, []int) {
+	return file_semanticdb_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *MatchType_CaseType) GetKey() *Type {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *MatchType_CaseType) GetBody() *Type {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
 var File_semanticdb_proto protoreflect.FileDescriptor
 
 var file_semanticdb_proto_rawDesc = []byte{
@@ -4178,7 +4518,7 @@ var file_semanticdb_proto_rawDesc = []byte{
 	0x31, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74,
 	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62,
 	0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x09, 0x68, 0x61, 0x72, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xcf, 0x09,
+	0x6f, 0x6e, 0x52, 0x09, 0x68, 0x61, 0x72, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xea, 0x0a,
 	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x72,
 	0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
 	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73,
@@ -4251,250 +4591,291 @@ var file_semanticdb_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
 	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x52, 0x65, 0x70,
 	0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x65, 0x61,
-	0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a,
-	0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10,
-	0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x4a, 0x04, 0x08,
-	0x0c, 0x10, 0x0d, 0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x4a, 0x04, 0x08, 0x10, 0x10, 0x11, 0x22,
-	0xac, 0x01, 0x0a, 0x07, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x12, 0x3c, 0x0a, 0x06, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x0b, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x4c, 0x61, 0x6d, 0x62,
+	0x64, 0x61, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
+	0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06,
+	0x10, 0x07, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x4a, 0x04, 0x08, 0x0c, 0x10, 0x0d, 0x4a, 0x04,
+	0x08, 0x0f, 0x10, 0x10, 0x4a, 0x04, 0x08, 0x10, 0x10, 0x11, 0x22, 0xac, 0x01, 0x0a, 0x07, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d,
+	0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x4b, 0x0a, 0x0e,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e,
+	0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x74, 0x79, 0x70, 0x65,
+	0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x62, 0x0a, 0x0a, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65,
+	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x22, 0x0a,
+	0x08, 0x54, 0x68, 0x69, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x22, 0x61, 0x0a, 0x09, 0x53, 0x75, 0x70, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x54, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d,
+	0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x10, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a,
+	0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x09, 0x55, 0x6e,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d,
+	0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x3a, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36,
+	0x0a, 0x03, 0x74, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63,
 	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
 	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x12, 0x4b, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c,
-	0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
-	0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0d, 0x74, 0x79, 0x70, 0x65, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x62,
-	0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x06,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
+	0x65, 0x52, 0x03, 0x74, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
 	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x22, 0x22, 0x0a, 0x08, 0x54, 0x68, 0x69, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x61, 0x0a, 0x09, 0x53, 0x75, 0x70, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
-	0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x54, 0x0a, 0x0c, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x63,
-	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x22,
-	0x4e, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08,
+	0x03, 0x10, 0x04, 0x22, 0x9b, 0x01, 0x0a, 0x0d, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64,
+	0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
 	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
-	0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22,
-	0x47, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x05,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63,
-	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70, 0x65, 0x12, 0x49, 0x0a,
+	0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
 	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
-	0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x22, 0xa5, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x61, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64,
-	0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x64,
-	0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
+	0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6c,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x9d,
+	0x01, 0x0a, 0x0d, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x4e, 0x0a, 0x0f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x44,
+	0x0a, 0x0a, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x03,
+	0x74, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x03, 0x74, 0x70, 0x65, 0x22, 0x46, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
 	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
-	0x64, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02,
-	0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x9b, 0x01, 0x0a, 0x0d, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74,
+	0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70, 0x65, 0x22, 0x97, 0x02, 0x0a,
+	0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x63,
+	0x72, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x73, 0x63, 0x72, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x65, 0x12, 0x48,
+	0x0a, 0x05, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x05, 0x63, 0x61, 0x73, 0x65, 0x73, 0x1a, 0x7c, 0x0a, 0x08, 0x43, 0x61, 0x73, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
+	0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x9a, 0x01, 0x0a, 0x0a, 0x4c, 0x61, 0x6d, 0x62, 0x64,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x45, 0x0a, 0x0b,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
+	0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0xd9, 0x07, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x12, 0x53, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65,
+	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74,
 	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62,
-	0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65,
-	0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61,
-	0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70, 0x65,
-	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x0f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x42, 0x79, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64,
+	0x62, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x12, 0x53, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e,
 	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65,
-	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74,
-	0x70, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
-	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73,
-	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52,
-	0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x04, 0x08,
-	0x02, 0x10, 0x03, 0x22, 0x9d, 0x01, 0x0a, 0x0d, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x61,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e,
-	0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
-	0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70, 0x65, 0x4a, 0x04, 0x08,
-	0x01, 0x10, 0x02, 0x22, 0x44, 0x0a, 0x0a, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x0d, 0x6c, 0x6f, 0x6e, 0x67, 0x5f,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
 	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65,
 	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70, 0x65, 0x22, 0x46, 0x0a, 0x0c, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d,
-	0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d,
-	0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70,
-	0x65, 0x22, 0xd9, 0x07, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x53,
-	0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65,
-	0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61,
-	0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x63,
-	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x4c, 0x6f, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c,
+	0x6c, 0x6f, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x0e,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e,
+	0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x12, 0x59, 0x0a, 0x0f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
 	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x0f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x74, 0x12, 0x53, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x0e, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12,
+	0x59, 0x0a, 0x0f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
 	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73,
-	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
-	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e,
-	0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52,
-	0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x53,
-	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65,
-	0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61,
-	0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x63, 0x61, 0x6c,
-	0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
-	0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x0d, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73,
-	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x4c, 0x6f,
-	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f,
-	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x0e, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
-	0x63, 0x64, 0x62, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x0d, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x12, 0x59, 0x0a, 0x0f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x63,
-	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x64,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x59, 0x0a,
-	0x0f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d,
-	0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d,
-	0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x0d, 0x6e, 0x75, 0x6c, 0x6c,
-	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62,
-	0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52,
-	0x0c, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x42, 0x0e, 0x0a,
-	0x0c, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x0e, 0x0a,
-	0x0c, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x22, 0x27, 0x0a,
-	0x0f, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x24, 0x0a, 0x0c, 0x42, 0x79, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x25, 0x0a, 0x0d,
-	0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x24, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23, 0x0a, 0x0b, 0x49, 0x6e, 0x74,
+	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x0d, 0x6e, 0x75,
+	0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
+	0x64, 0x62, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x0c, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x42,
+	0x0e, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x0e, 0x0a, 0x0c, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x22,
+	0x27, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x24, 0x0a, 0x0c, 0x42, 0x79, 0x74, 0x65,
 	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x24,
-	0x0a, 0x0c, 0x4c, 0x6f, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x25, 0x0a, 0x0d, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x26, 0x0a, 0x0e, 0x44,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x26, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x25,
+	0x0a, 0x0d, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x24, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x72, 0x43, 0x6f, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x4e,
-	0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x22, 0x87, 0x03, 0x0a, 0x09,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x63, 0x6c, 0x61,
-	0x73, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
-	0x63, 0x64, 0x62, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
-	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48,
-	0x00, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x61,
-	0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x79, 0x70,
-	0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23, 0x0a, 0x0b, 0x49,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x24, 0x0a, 0x0c, 0x4c, 0x6f, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x25, 0x0a, 0x0d, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x26, 0x0a,
+	0x0e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x26, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x0e, 0x0a,
+	0x0c, 0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x22, 0x87, 0x03,
+	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e,
+	0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64,
+	0x62, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74,
+	0x79, 0x70, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x59, 0x0a, 0x0f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61,
+	0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x6c, 0x65,
+	0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa5, 0x02, 0x0a, 0x0e, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
 	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
-	0x69, 0x63, 0x64, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa5, 0x02, 0x0a, 0x0e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x74, 0x79, 0x70, 0x65,
-	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
-	0x64, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c,
-	0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
-	0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x73, 0x65, 0x6c, 0x66,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d,
-	0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d,
-	0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x73, 0x65,
-	0x6c, 0x66, 0x12, 0x49, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x0e, 0x74, 0x79, 0x70, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x73, 0x65,
+	0x6c, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
 	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73,
-	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52,
-	0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf8, 0x01,
-	0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61,
+	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x73, 0x65, 0x6c, 0x66, 0x12, 0x49, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61,
 	0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
 	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x4e, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61,
+	0x65, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xb6, 0x02, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73,
+	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x74, 0x68,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61,
 	0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x73, 0x12, 0x45, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d,
-	0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d,
-	0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0xed, 0x01, 0x0a, 0x0d, 0x54, 0x79, 0x70,
+	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x06, 0x74, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x22, 0xed, 0x01, 0x0a, 0x0d, 0x54, 0x79, 0x70,
 	0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x74, 0x79,
 	0x70, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
@@ -4514,7 +4895,7 @@ var file_semanticdb_proto_rawDesc = []byte{
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e,
 	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65,
 	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74,
-	0x70, 0x65, 0x22, 0x88, 0x08, 0x0a, 0x11, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66,
+	0x70, 0x65, 0x22, 0xe2, 0x09, 0x0a, 0x11, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66,
 	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
 	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
 	0x12, 0x44, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01,
@@ -4543,254 +4924,280 @@ var file_semanticdb_proto_rawDesc = []byte{
 	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
 	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
 	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x8d, 0x02, 0x0a,
-	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c,
-	0x10, 0x13, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x14, 0x12, 0x0a, 0x0a,
-	0x06, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4e,
-	0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x15, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x41,
-	0x43, 0x52, 0x4f, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x59, 0x50, 0x45, 0x10, 0x07, 0x12,
-	0x0d, 0x0a, 0x09, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x10, 0x08, 0x12, 0x12,
-	0x0a, 0x0e, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52,
-	0x10, 0x11, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d,
-	0x45, 0x54, 0x45, 0x52, 0x10, 0x09, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54,
-	0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x10, 0x0b, 0x12,
-	0x12, 0x0a, 0x0e, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43,
-	0x54, 0x10, 0x0c, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x10, 0x0d, 0x12, 0x09,
-	0x0a, 0x05, 0x54, 0x52, 0x41, 0x49, 0x54, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54,
-	0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x12, 0x22, 0x04, 0x08, 0x01, 0x10, 0x01, 0x22, 0x04,
-	0x08, 0x02, 0x10, 0x02, 0x22, 0x04, 0x08, 0x04, 0x10, 0x04, 0x22, 0x04, 0x08, 0x05, 0x10, 0x05,
-	0x22, 0x04, 0x08, 0x0f, 0x10, 0x0f, 0x22, 0x04, 0x08, 0x10, 0x10, 0x10, 0x22, 0xe2, 0x01, 0x0a,
-	0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x10, 0x00, 0x12,
-	0x0c, 0x0a, 0x08, 0x41, 0x42, 0x53, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x04, 0x12, 0x09, 0x0a,
-	0x05, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x41, 0x4c,
-	0x45, 0x44, 0x10, 0x10, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4d, 0x50, 0x4c, 0x49, 0x43, 0x49, 0x54,
-	0x10, 0x20, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x5a, 0x59, 0x10, 0x40, 0x12, 0x09, 0x0a, 0x04,
-	0x43, 0x41, 0x53, 0x45, 0x10, 0x80, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x43, 0x4f, 0x56, 0x41, 0x52,
-	0x49, 0x41, 0x4e, 0x54, 0x10, 0x80, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x54, 0x52,
-	0x41, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x10, 0x80, 0x04, 0x12, 0x08, 0x0a, 0x03, 0x56,
-	0x41, 0x4c, 0x10, 0x80, 0x08, 0x12, 0x08, 0x0a, 0x03, 0x56, 0x41, 0x52, 0x10, 0x80, 0x10, 0x12,
-	0x0b, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x80, 0x20, 0x12, 0x0c, 0x0a, 0x07,
-	0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x80, 0x40, 0x12, 0x0a, 0x0a, 0x04, 0x45, 0x4e,
-	0x55, 0x4d, 0x10, 0x80, 0x80, 0x01, 0x12, 0x0d, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
-	0x54, 0x10, 0x80, 0x80, 0x02, 0x22, 0x04, 0x08, 0x01, 0x10, 0x01, 0x22, 0x04, 0x08, 0x02, 0x10,
-	0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08,
-	0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x4a,
-	0x04, 0x08, 0x0a, 0x10, 0x0b, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x4a, 0x04, 0x08, 0x0c, 0x10,
-	0x0d, 0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f, 0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x22, 0x44, 0x0a,
-	0x0a, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x03, 0x74,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
-	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73,
-	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03,
-	0x74, 0x70, 0x65, 0x22, 0xcf, 0x05, 0x0a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x56,
-	0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d,
-	0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d,
-	0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x63, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x68, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x53, 0x0a, 0x0d, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
+	0x63, 0x64, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x8d, 0x02, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c,
+	0x4f, 0x43, 0x41, 0x4c, 0x10, 0x13, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x10,
+	0x14, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x15, 0x12, 0x09,
+	0x0a, 0x05, 0x4d, 0x41, 0x43, 0x52, 0x4f, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x59, 0x50,
+	0x45, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52,
+	0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d,
+	0x45, 0x54, 0x45, 0x52, 0x10, 0x11, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
+	0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x10, 0x09, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x42,
+	0x4a, 0x45, 0x43, 0x54, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47,
+	0x45, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x4f,
+	0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x0c, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x10, 0x0d, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x49, 0x54, 0x10, 0x0e, 0x12, 0x0d, 0x0a,
+	0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x12, 0x22, 0x04, 0x08, 0x01,
+	0x10, 0x01, 0x22, 0x04, 0x08, 0x02, 0x10, 0x02, 0x22, 0x04, 0x08, 0x04, 0x10, 0x04, 0x22, 0x04,
+	0x08, 0x05, 0x10, 0x05, 0x22, 0x04, 0x08, 0x0f, 0x10, 0x0f, 0x22, 0x04, 0x08, 0x10, 0x10, 0x10,
+	0x22, 0xb8, 0x02, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54,
+	0x59, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x42, 0x53, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10,
+	0x04, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x10, 0x10, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4d, 0x50, 0x4c,
+	0x49, 0x43, 0x49, 0x54, 0x10, 0x20, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x5a, 0x59, 0x10, 0x40,
+	0x12, 0x09, 0x0a, 0x04, 0x43, 0x41, 0x53, 0x45, 0x10, 0x80, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x43,
+	0x4f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x10, 0x80, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x43,
+	0x4f, 0x4e, 0x54, 0x52, 0x41, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x10, 0x80, 0x04, 0x12,
+	0x08, 0x0a, 0x03, 0x56, 0x41, 0x4c, 0x10, 0x80, 0x08, 0x12, 0x08, 0x0a, 0x03, 0x56, 0x41, 0x52,
+	0x10, 0x80, 0x10, 0x12, 0x0b, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x80, 0x20,
+	0x12, 0x0c, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x80, 0x40, 0x12, 0x0a,
+	0x0a, 0x04, 0x45, 0x4e, 0x55, 0x4d, 0x10, 0x80, 0x80, 0x01, 0x12, 0x0d, 0x0a, 0x07, 0x44, 0x45,
+	0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x80, 0x80, 0x02, 0x12, 0x0b, 0x0a, 0x05, 0x47, 0x49, 0x56,
+	0x45, 0x4e, 0x10, 0x80, 0x80, 0x04, 0x12, 0x0c, 0x0a, 0x06, 0x49, 0x4e, 0x4c, 0x49, 0x4e, 0x45,
+	0x10, 0x80, 0x80, 0x08, 0x12, 0x0a, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x80, 0x80, 0x10,
+	0x12, 0x11, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x50, 0x41, 0x52, 0x45, 0x4e, 0x54, 0x10,
+	0x80, 0x80, 0x20, 0x12, 0x0b, 0x0a, 0x05, 0x49, 0x4e, 0x46, 0x49, 0x58, 0x10, 0x80, 0x80, 0x40,
+	0x12, 0x0d, 0x0a, 0x06, 0x4f, 0x50, 0x41, 0x51, 0x55, 0x45, 0x10, 0x80, 0x80, 0x80, 0x01, 0x22,
+	0x04, 0x08, 0x01, 0x10, 0x01, 0x22, 0x04, 0x08, 0x02, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08,
+	0x08, 0x10, 0x09, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x0b, 0x4a,
+	0x04, 0x08, 0x0b, 0x10, 0x0c, 0x4a, 0x04, 0x08, 0x0c, 0x10, 0x0d, 0x4a, 0x04, 0x08, 0x0e, 0x10,
+	0x0f, 0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x22, 0xbe, 0x01, 0x0a, 0x0d, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
 	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
-	0x69, 0x63, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x68, 0x69, 0x73,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x11, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x54, 0x68, 0x69, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x69, 0x0a, 0x15, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x63, 0x61,
-	0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48,
-	0x00, 0x52, 0x13, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5c, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2f, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64,
-	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x5f, 0x74, 0x68, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x69, 0x63, 0x64, 0x62, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x22, 0x45, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x48,
+	0x54, 0x4d, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x52, 0x4b, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4a, 0x41, 0x56, 0x41, 0x44, 0x4f, 0x43, 0x10, 0x02,
+	0x12, 0x0c, 0x0a, 0x08, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x44, 0x4f, 0x43, 0x10, 0x03, 0x12, 0x08,
+	0x0a, 0x04, 0x4b, 0x44, 0x4f, 0x43, 0x10, 0x04, 0x22, 0x44, 0x0a, 0x0a, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
 	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
-	0x69, 0x63, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x68,
-	0x69, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x74,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x68, 0x69, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x6f, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74,
-	0x68, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x35, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64,
-	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x69,
-	0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x53, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e,
-	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65,
-	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x54, 0x68, 0x69, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x11, 0x0a, 0x0f, 0x50, 0x72,
-	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x15, 0x0a,
-	0x13, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x68, 0x69, 0x73, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0x2f, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x61,
-	0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c,
-	0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
-	0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
-	0x49, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e,
-	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
-	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x37, 0x0a, 0x04, 0x52, 0x6f,
-	0x6c, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52, 0x4f,
-	0x4c, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43,
-	0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x02, 0x22, 0x89, 0x02, 0x0a, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
-	0x69, 0x63, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
+	0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70, 0x65, 0x22, 0xcf,
+	0x05, 0x0a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x0e, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
 	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
-	0x64, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x4f, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x33, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
-	0x64, 0x62, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x53, 0x65,
-	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x53, 0x0a, 0x08, 0x53, 0x65,
-	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49,
-	0x4e, 0x47, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x4e, 0x54, 0x10, 0x04, 0x22,
-	0x82, 0x01, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x74, 0x69, 0x63, 0x12, 0x3b, 0x0a,
+	0x64, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x63, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x69,
+	0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x68, 0x69, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x11, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x68, 0x69, 0x73,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61,
+	0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x13, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x5c, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0f,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x69, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x69,
+	0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x68, 0x69, 0x73, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x54, 0x68, 0x69, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x6f, 0x0a, 0x17, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x57,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x53, 0x0a, 0x0d, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
+	0x63, 0x64, 0x62, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x0f, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x68, 0x69, 0x73,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x11, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x68, 0x69, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x2f, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0xeb, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e,
+	0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x49, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73,
+	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x37, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a,
+	0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0e,
+	0x0a, 0x0a, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x22, 0x89,
+	0x02, 0x0a, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x12, 0x3b, 0x0a,
 	0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
 	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
 	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x52, 0x61,
-	0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x72,
-	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
-	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73,
-	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x04,
-	0x74, 0x72, 0x65, 0x65, 0x22, 0xb1, 0x05, 0x0a, 0x04, 0x54, 0x72, 0x65, 0x65, 0x12, 0x4a, 0x0a,
-	0x0a, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
-	0x64, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x09,
-	0x61, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x72, 0x65, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x66, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64,
-	0x62, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00,
-	0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x41,
-	0x0a, 0x07, 0x69, 0x64, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62,
-	0x2e, 0x49, 0x64, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x06, 0x69, 0x64, 0x54, 0x72, 0x65,
-	0x65, 0x12, 0x50, 0x0a, 0x0c, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x65,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e,
+	0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4f, 0x0a, 0x08, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x53, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x45, 0x56,
+	0x45, 0x52, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03,
+	0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x4e, 0x54, 0x10, 0x04, 0x22, 0x82, 0x01, 0x0a, 0x09, 0x53,
+	0x79, 0x6e, 0x74, 0x68, 0x65, 0x74, 0x69, 0x63, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e,
 	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65,
-	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x54,
-	0x72, 0x65, 0x65, 0x12, 0x66, 0x0a, 0x14, 0x6d, 0x61, 0x63, 0x72, 0x6f, 0x5f, 0x65, 0x78, 0x70,
-	0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x32, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
-	0x64, 0x62, 0x2e, 0x4d, 0x61, 0x63, 0x72, 0x6f, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x61, 0x63, 0x72, 0x6f, 0x45, 0x78,
-	0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
-	0x63, 0x64, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x65, 0x65,
-	0x48, 0x00, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x65, 0x65,
-	0x12, 0x4d, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65,
-	0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61,
-	0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x72, 0x65,
-	0x65, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12,
-	0x57, 0x0a, 0x0f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x72,
-	0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
-	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73,
-	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x41, 0x70,
-	0x70, 0x6c, 0x79, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x79, 0x70, 0x65, 0x41,
-	0x70, 0x70, 0x6c, 0x79, 0x54, 0x72, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x6c,
-	0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x54, 0x72, 0x65, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61,
-	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73,
-	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x08,
-	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63,
-	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65,
-	0x65, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a,
-	0x0c, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x46, 0x0a,
-	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
-	0x64, 0x62, 0x2e, 0x49, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20,
+	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
 	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
-	0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22,
-	0x20, 0x0a, 0x06, 0x49, 0x64, 0x54, 0x72, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x22, 0x53, 0x0a, 0x0b, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x65, 0x65,
-	0x12, 0x44, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
-	0x63, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x63, 0x72, 0x6f,
-	0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x4f, 0x0a,
-	0x10, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e,
-	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65,
-	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x0f, 0x62,
-	0x65, 0x66, 0x6f, 0x72, 0x65, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36,
-	0x0a, 0x03, 0x74, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63,
+	0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x04, 0x74, 0x72, 0x65, 0x65, 0x22,
+	0xb1, 0x05, 0x0a, 0x04, 0x54, 0x72, 0x65, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c,
+	0x79, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x54, 0x72, 0x65, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x63,
 	0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x03, 0x74, 0x70, 0x65, 0x22, 0x4b, 0x0a, 0x0c, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65,
-	0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61,
-	0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61,
-	0x6e, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x72,
-	0x65, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65,
-	0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61,
-	0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x09, 0x71, 0x75, 0x61,
-	0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
+	0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x69, 0x64, 0x5f,
+	0x74, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x49, 0x64, 0x54, 0x72,
+	0x65, 0x65, 0x48, 0x00, 0x52, 0x06, 0x69, 0x64, 0x54, 0x72, 0x65, 0x65, 0x12, 0x50, 0x0a, 0x0c,
+	0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
 	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
-	0x63, 0x64, 0x62, 0x2e, 0x49, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9e,
-	0x01, 0x0a, 0x0d, 0x54, 0x79, 0x70, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x72, 0x65, 0x65,
-	0x12, 0x40, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x63, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x48,
+	0x00, 0x52, 0x0b, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x12, 0x66,
+	0x0a, 0x14, 0x6d, 0x61, 0x63, 0x72, 0x6f, 0x5f, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x4d, 0x61,
+	0x63, 0x72, 0x6f, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65,
+	0x48, 0x00, 0x52, 0x12, 0x6d, 0x61, 0x63, 0x72, 0x6f, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x12, 0x4d, 0x0a, 0x0b, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64,
+	0x62, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x0a,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x57, 0x0a, 0x0f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
+	0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x72,
+	0x65, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x79, 0x70, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54,
+	0x72, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x72, 0x65,
+	0x65, 0x12, 0x40, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
+	0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d,
+	0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x09, 0x61, 0x72,
+	0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x49, 0x64,
+	0x54, 0x72, 0x65, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x38, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e,
+	0x54, 0x72, 0x65, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x20, 0x0a, 0x06, 0x49, 0x64,
+	0x54, 0x72, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x53, 0x0a, 0x0b,
+	0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x22, 0x9d, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x63, 0x72, 0x6f, 0x45, 0x78, 0x70, 0x61, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
 	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
-	0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61,
-	0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0d, 0x74, 0x79, 0x70, 0x65, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a,
-	0x36, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x47,
-	0x41, 0x43, 0x59, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54, 0x49,
-	0x43, 0x44, 0x42, 0x33, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54,
-	0x49, 0x43, 0x44, 0x42, 0x34, 0x10, 0x04, 0x2a, 0x35, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4c,
-	0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x43, 0x41,
-	0x4c, 0x41, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x41, 0x56, 0x41, 0x10, 0x02, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x64, 0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x0f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d,
+	0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x70,
+	0x65, 0x22, 0x4b, 0x0a, 0x0c, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x65,
+	0x65, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64,
+	0x62, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x88,
+	0x01, 0x0a, 0x0a, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x42, 0x0a,
+	0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64,
+	0x62, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x36, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x49,
+	0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0d, 0x54, 0x79,
+	0x70, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x72, 0x65, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54,
+	0x72, 0x65, 0x65, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a,
+	0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x6d, 0x61,
+	0x6e, 0x74, 0x69, 0x63, 0x64, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x74, 0x79, 0x70,
+	0x65, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x36, 0x0a, 0x06, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54, 0x49, 0x43, 0x44, 0x42, 0x33, 0x10,
+	0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x45, 0x4d, 0x41, 0x4e, 0x54, 0x49, 0x43, 0x44, 0x42, 0x34,
+	0x10, 0x04, 0x2a, 0x35, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41,
+	0x47, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x4a, 0x41, 0x56, 0x41, 0x10, 0x02, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -4805,187 +5212,203 @@ func file_semanticdb_proto_rawDescGZIP() []byte {
 	return file_semanticdb_proto_rawDescData
 }
 
-var file_semanticdb_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_semanticdb_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_semanticdb_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_semanticdb_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_semanticdb_proto_goTypes = []interface{}{
 	(Schema)(0),                     // 0: scala.meta.internal.semanticdb.Schema
 	(Language)(0),                   // 1: scala.meta.internal.semanticdb.Language
 	(SymbolInformation_Kind)(0),     // 2: scala.meta.internal.semanticdb.SymbolInformation.Kind
 	(SymbolInformation_Property)(0), // 3: scala.meta.internal.semanticdb.SymbolInformation.Property
-	(SymbolOccurrence_Role)(0),      // 4: scala.meta.internal.semanticdb.SymbolOccurrence.Role
-	(Diagnostic_Severity)(0),        // 5: scala.meta.internal.semanticdb.Diagnostic.Severity
-	(*TextDocuments)(nil),           // 6: scala.meta.internal.semanticdb.TextDocuments
-	(*TextDocument)(nil),            // 7: scala.meta.internal.semanticdb.TextDocument
-	(*Range)(nil),                   // 8: scala.meta.internal.semanticdb.Range
-	(*Location)(nil),                // 9: scala.meta.internal.semanticdb.Location
-	(*Scope)(nil),                   // 10: scala.meta.internal.semanticdb.Scope
-	(*Type)(nil),                    // 11: scala.meta.internal.semanticdb.Type
-	(*TypeRef)(nil),                 // 12: scala.meta.internal.semanticdb.TypeRef
-	(*SingleType)(nil),              // 13: scala.meta.internal.semanticdb.SingleType
-	(*ThisType)(nil),                // 14: scala.meta.internal.semanticdb.ThisType
-	(*SuperType)(nil),               // 15: scala.meta.internal.semanticdb.SuperType
-	(*ConstantType)(nil),            // 16: scala.meta.internal.semanticdb.ConstantType
-	(*IntersectionType)(nil),        // 17: scala.meta.internal.semanticdb.IntersectionType
-	(*UnionType)(nil),               // 18: scala.meta.internal.semanticdb.UnionType
-	(*WithType)(nil),                // 19: scala.meta.internal.semanticdb.WithType
-	(*StructuralType)(nil),          // 20: scala.meta.internal.semanticdb.StructuralType
-	(*AnnotatedType)(nil),           // 21: scala.meta.internal.semanticdb.AnnotatedType
-	(*ExistentialType)(nil),         // 22: scala.meta.internal.semanticdb.ExistentialType
-	(*UniversalType)(nil),           // 23: scala.meta.internal.semanticdb.UniversalType
-	(*ByNameType)(nil),              // 24: scala.meta.internal.semanticdb.ByNameType
-	(*RepeatedType)(nil),            // 25: scala.meta.internal.semanticdb.RepeatedType
-	(*Constant)(nil),                // 26: scala.meta.internal.semanticdb.Constant
-	(*UnitConstant)(nil),            // 27: scala.meta.internal.semanticdb.UnitConstant
-	(*BooleanConstant)(nil),         // 28: scala.meta.internal.semanticdb.BooleanConstant
-	(*ByteConstant)(nil),            // 29: scala.meta.internal.semanticdb.ByteConstant
-	(*ShortConstant)(nil),           // 30: scala.meta.internal.semanticdb.ShortConstant
-	(*CharConstant)(nil),            // 31: scala.meta.internal.semanticdb.CharConstant
-	(*IntConstant)(nil),             // 32: scala.meta.internal.semanticdb.IntConstant
-	(*LongConstant)(nil),            // 33: scala.meta.internal.semanticdb.LongConstant
-	(*FloatConstant)(nil),           // 34: scala.meta.internal.semanticdb.FloatConstant
-	(*DoubleConstant)(nil),          // 35: scala.meta.internal.semanticdb.DoubleConstant
-	(*StringConstant)(nil),          // 36: scala.meta.internal.semanticdb.StringConstant
-	(*NullConstant)(nil),            // 37: scala.meta.internal.semanticdb.NullConstant
-	(*Signature)(nil),               // 38: scala.meta.internal.semanticdb.Signature
-	(*ClassSignature)(nil),          // 39: scala.meta.internal.semanticdb.ClassSignature
-	(*MethodSignature)(nil),         // 40: scala.meta.internal.semanticdb.MethodSignature
-	(*TypeSignature)(nil),           // 41: scala.meta.internal.semanticdb.TypeSignature
-	(*ValueSignature)(nil),          // 42: scala.meta.internal.semanticdb.ValueSignature
-	(*SymbolInformation)(nil),       // 43: scala.meta.internal.semanticdb.SymbolInformation
-	(*Annotation)(nil),              // 44: scala.meta.internal.semanticdb.Annotation
-	(*Access)(nil),                  // 45: scala.meta.internal.semanticdb.Access
-	(*PrivateAccess)(nil),           // 46: scala.meta.internal.semanticdb.PrivateAccess
-	(*PrivateThisAccess)(nil),       // 47: scala.meta.internal.semanticdb.PrivateThisAccess
-	(*PrivateWithinAccess)(nil),     // 48: scala.meta.internal.semanticdb.PrivateWithinAccess
-	(*ProtectedAccess)(nil),         // 49: scala.meta.internal.semanticdb.ProtectedAccess
-	(*ProtectedThisAccess)(nil),     // 50: scala.meta.internal.semanticdb.ProtectedThisAccess
-	(*ProtectedWithinAccess)(nil),   // 51: scala.meta.internal.semanticdb.ProtectedWithinAccess
-	(*PublicAccess)(nil),            // 52: scala.meta.internal.semanticdb.PublicAccess
-	(*SymbolOccurrence)(nil),        // 53: scala.meta.internal.semanticdb.SymbolOccurrence
-	(*Diagnostic)(nil),              // 54: scala.meta.internal.semanticdb.Diagnostic
-	(*Synthetic)(nil),               // 55: scala.meta.internal.semanticdb.Synthetic
-	(*Tree)(nil),                    // 56: scala.meta.internal.semanticdb.Tree
-	(*ApplyTree)(nil),               // 57: scala.meta.internal.semanticdb.ApplyTree
-	(*FunctionTree)(nil),            // 58: scala.meta.internal.semanticdb.FunctionTree
-	(*IdTree)(nil),                  // 59: scala.meta.internal.semanticdb.IdTree
-	(*LiteralTree)(nil),             // 60: scala.meta.internal.semanticdb.LiteralTree
-	(*MacroExpansionTree)(nil),      // 61: scala.meta.internal.semanticdb.MacroExpansionTree
-	(*OriginalTree)(nil),            // 62: scala.meta.internal.semanticdb.OriginalTree
-	(*SelectTree)(nil),              // 63: scala.meta.internal.semanticdb.SelectTree
-	(*TypeApplyTree)(nil),           // 64: scala.meta.internal.semanticdb.TypeApplyTree
+	(Documentation_Format)(0),       // 4: scala.meta.internal.semanticdb.Documentation.Format
+	(SymbolOccurrence_Role)(0),      // 5: scala.meta.internal.semanticdb.SymbolOccurrence.Role
+	(Diagnostic_Severity)(0),        // 6: scala.meta.internal.semanticdb.Diagnostic.Severity
+	(*TextDocuments)(nil),           // 7: scala.meta.internal.semanticdb.TextDocuments
+	(*TextDocument)(nil),            // 8: scala.meta.internal.semanticdb.TextDocument
+	(*Range)(nil),                   // 9: scala.meta.internal.semanticdb.Range
+	(*Location)(nil),                // 10: scala.meta.internal.semanticdb.Location
+	(*Scope)(nil),                   // 11: scala.meta.internal.semanticdb.Scope
+	(*Type)(nil),                    // 12: scala.meta.internal.semanticdb.Type
+	(*TypeRef)(nil),                 // 13: scala.meta.internal.semanticdb.TypeRef
+	(*SingleType)(nil),              // 14: scala.meta.internal.semanticdb.SingleType
+	(*ThisType)(nil),                // 15: scala.meta.internal.semanticdb.ThisType
+	(*SuperType)(nil),               // 16: scala.meta.internal.semanticdb.SuperType
+	(*ConstantType)(nil),            // 17: scala.meta.internal.semanticdb.ConstantType
+	(*IntersectionType)(nil),        // 18: scala.meta.internal.semanticdb.IntersectionType
+	(*UnionType)(nil),               // 19: scala.meta.internal.semanticdb.UnionType
+	(*WithType)(nil),                // 20: scala.meta.internal.semanticdb.WithType
+	(*StructuralType)(nil),          // 21: scala.meta.internal.semanticdb.StructuralType
+	(*AnnotatedType)(nil),           // 22: scala.meta.internal.semanticdb.AnnotatedType
+	(*ExistentialType)(nil),         // 23: scala.meta.internal.semanticdb.ExistentialType
+	(*UniversalType)(nil),           // 24: scala.meta.internal.semanticdb.UniversalType
+	(*ByNameType)(nil),              // 25: scala.meta.internal.semanticdb.ByNameType
+	(*RepeatedType)(nil),            // 26: scala.meta.internal.semanticdb.RepeatedType
+	(*MatchType)(nil),               // 27: scala.meta.internal.semanticdb.MatchType
+	(*LambdaType)(nil),              // 28: scala.meta.internal.semanticdb.LambdaType
+	(*Constant)(nil),                // 29: scala.meta.internal.semanticdb.Constant
+	(*UnitConstant)(nil),            // 30: scala.meta.internal.semanticdb.UnitConstant
+	(*BooleanConstant)(nil),         // 31: scala.meta.internal.semanticdb.BooleanConstant
+	(*ByteConstant)(nil),            // 32: scala.meta.internal.semanticdb.ByteConstant
+	(*ShortConstant)(nil),           // 33: scala.meta.internal.semanticdb.ShortConstant
+	(*CharConstant)(nil),            // 34: scala.meta.internal.semanticdb.CharConstant
+	(*IntConstant)(nil),             // 35: scala.meta.internal.semanticdb.IntConstant
+	(*LongConstant)(nil),            // 36: scala.meta.internal.semanticdb.LongConstant
+	(*FloatConstant)(nil),           // 37: scala.meta.internal.semanticdb.FloatConstant
+	(*DoubleConstant)(nil),          // 38: scala.meta.internal.semanticdb.DoubleConstant
+	(*StringConstant)(nil),          // 39: scala.meta.internal.semanticdb.StringConstant
+	(*NullConstant)(nil),            // 40: scala.meta.internal.semanticdb.NullConstant
+	(*Signature)(nil),               // 41: scala.meta.internal.semanticdb.Signature
+	(*ClassSignature)(nil),          // 42: scala.meta.internal.semanticdb.ClassSignature
+	(*MethodSignature)(nil),         // 43: scala.meta.internal.semanticdb.MethodSignature
+	(*TypeSignature)(nil),           // 44: scala.meta.internal.semanticdb.TypeSignature
+	(*ValueSignature)(nil),          // 45: scala.meta.internal.semanticdb.ValueSignature
+	(*SymbolInformation)(nil),       // 46: scala.meta.internal.semanticdb.SymbolInformation
+	(*Documentation)(nil),           // 47: scala.meta.internal.semanticdb.Documentation
+	(*Annotation)(nil),              // 48: scala.meta.internal.semanticdb.Annotation
+	(*Access)(nil),                  // 49: scala.meta.internal.semanticdb.Access
+	(*PrivateAccess)(nil),           // 50: scala.meta.internal.semanticdb.PrivateAccess
+	(*PrivateThisAccess)(nil),       // 51: scala.meta.internal.semanticdb.PrivateThisAccess
+	(*PrivateWithinAccess)(nil),     // 52: scala.meta.internal.semanticdb.PrivateWithinAccess
+	(*ProtectedAccess)(nil),         // 53: scala.meta.internal.semanticdb.ProtectedAccess
+	(*ProtectedThisAccess)(nil),     // 54: scala.meta.internal.semanticdb.ProtectedThisAccess
+	(*ProtectedWithinAccess)(nil),   // 55: scala.meta.internal.semanticdb.ProtectedWithinAccess
+	(*PublicAccess)(nil),            // 56: scala.meta.internal.semanticdb.PublicAccess
+	(*SymbolOccurrence)(nil),        // 57: scala.meta.internal.semanticdb.SymbolOccurrence
+	(*Diagnostic)(nil),              // 58: scala.meta.internal.semanticdb.Diagnostic
+	(*Synthetic)(nil),               // 59: scala.meta.internal.semanticdb.Synthetic
+	(*Tree)(nil),                    // 60: scala.meta.internal.semanticdb.Tree
+	(*ApplyTree)(nil),               // 61: scala.meta.internal.semanticdb.ApplyTree
+	(*FunctionTree)(nil),            // 62: scala.meta.internal.semanticdb.FunctionTree
+	(*IdTree)(nil),                  // 63: scala.meta.internal.semanticdb.IdTree
+	(*LiteralTree)(nil),             // 64: scala.meta.internal.semanticdb.LiteralTree
+	(*MacroExpansionTree)(nil),      // 65: scala.meta.internal.semanticdb.MacroExpansionTree
+	(*OriginalTree)(nil),            // 66: scala.meta.internal.semanticdb.OriginalTree
+	(*SelectTree)(nil),              // 67: scala.meta.internal.semanticdb.SelectTree
+	(*TypeApplyTree)(nil),           // 68: scala.meta.internal.semanticdb.TypeApplyTree
+	(*MatchType_CaseType)(nil),      // 69: scala.meta.internal.semanticdb.MatchType.CaseType
 }
 var file_semanticdb_proto_depIdxs = []int32{
-	7,   // 0: scala.meta.internal.semanticdb.TextDocuments.documents:type_name -> scala.meta.internal.semanticdb.TextDocument
+	8,   // 0: scala.meta.internal.semanticdb.TextDocuments.documents:type_name -> scala.meta.internal.semanticdb.TextDocument
 	0,   // 1: scala.meta.internal.semanticdb.TextDocument.schema:type_name -> scala.meta.internal.semanticdb.Schema
 	1,   // 2: scala.meta.internal.semanticdb.TextDocument.language:type_name -> scala.meta.internal.semanticdb.Language
-	43,  // 3: scala.meta.internal.semanticdb.TextDocument.symbols:type_name -> scala.meta.internal.semanticdb.SymbolInformation
-	53,  // 4: scala.meta.internal.semanticdb.TextDocument.occurrences:type_name -> scala.meta.internal.semanticdb.SymbolOccurrence
-	54,  // 5: scala.meta.internal.semanticdb.TextDocument.diagnostics:type_name -> scala.meta.internal.semanticdb.Diagnostic
-	55,  // 6: scala.meta.internal.semanticdb.TextDocument.synthetics:type_name -> scala.meta.internal.semanticdb.Synthetic
-	8,   // 7: scala.meta.internal.semanticdb.Location.range:type_name -> scala.meta.internal.semanticdb.Range
-	43,  // 8: scala.meta.internal.semanticdb.Scope.hardlinks:type_name -> scala.meta.internal.semanticdb.SymbolInformation
-	12,  // 9: scala.meta.internal.semanticdb.Type.type_ref:type_name -> scala.meta.internal.semanticdb.TypeRef
-	13,  // 10: scala.meta.internal.semanticdb.Type.single_type:type_name -> scala.meta.internal.semanticdb.SingleType
-	14,  // 11: scala.meta.internal.semanticdb.Type.this_type:type_name -> scala.meta.internal.semanticdb.ThisType
-	15,  // 12: scala.meta.internal.semanticdb.Type.super_type:type_name -> scala.meta.internal.semanticdb.SuperType
-	16,  // 13: scala.meta.internal.semanticdb.Type.constant_type:type_name -> scala.meta.internal.semanticdb.ConstantType
-	17,  // 14: scala.meta.internal.semanticdb.Type.intersection_type:type_name -> scala.meta.internal.semanticdb.IntersectionType
-	18,  // 15: scala.meta.internal.semanticdb.Type.union_type:type_name -> scala.meta.internal.semanticdb.UnionType
-	19,  // 16: scala.meta.internal.semanticdb.Type.with_type:type_name -> scala.meta.internal.semanticdb.WithType
-	20,  // 17: scala.meta.internal.semanticdb.Type.structural_type:type_name -> scala.meta.internal.semanticdb.StructuralType
-	21,  // 18: scala.meta.internal.semanticdb.Type.annotated_type:type_name -> scala.meta.internal.semanticdb.AnnotatedType
-	22,  // 19: scala.meta.internal.semanticdb.Type.existential_type:type_name -> scala.meta.internal.semanticdb.ExistentialType
-	23,  // 20: scala.meta.internal.semanticdb.Type.universal_type:type_name -> scala.meta.internal.semanticdb.UniversalType
-	24,  // 21: scala.meta.internal.semanticdb.Type.by_name_type:type_name -> scala.meta.internal.semanticdb.ByNameType
-	25,  // 22: scala.meta.internal.semanticdb.Type.repeated_type:type_name -> scala.meta.internal.semanticdb.RepeatedType
-	11,  // 23: scala.meta.internal.semanticdb.TypeRef.prefix:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 24: scala.meta.internal.semanticdb.TypeRef.type_arguments:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 25: scala.meta.internal.semanticdb.SingleType.prefix:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 26: scala.meta.internal.semanticdb.SuperType.prefix:type_name -> scala.meta.internal.semanticdb.Type
-	26,  // 27: scala.meta.internal.semanticdb.ConstantType.constant:type_name -> scala.meta.internal.semanticdb.Constant
-	11,  // 28: scala.meta.internal.semanticdb.IntersectionType.types:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 29: scala.meta.internal.semanticdb.UnionType.types:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 30: scala.meta.internal.semanticdb.WithType.types:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 31: scala.meta.internal.semanticdb.StructuralType.tpe:type_name -> scala.meta.internal.semanticdb.Type
-	10,  // 32: scala.meta.internal.semanticdb.StructuralType.declarations:type_name -> scala.meta.internal.semanticdb.Scope
-	44,  // 33: scala.meta.internal.semanticdb.AnnotatedType.annotations:type_name -> scala.meta.internal.semanticdb.Annotation
-	11,  // 34: scala.meta.internal.semanticdb.AnnotatedType.tpe:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 35: scala.meta.internal.semanticdb.ExistentialType.tpe:type_name -> scala.meta.internal.semanticdb.Type
-	10,  // 36: scala.meta.internal.semanticdb.ExistentialType.declarations:type_name -> scala.meta.internal.semanticdb.Scope
-	10,  // 37: scala.meta.internal.semanticdb.UniversalType.type_parameters:type_name -> scala.meta.internal.semanticdb.Scope
-	11,  // 38: scala.meta.internal.semanticdb.UniversalType.tpe:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 39: scala.meta.internal.semanticdb.ByNameType.tpe:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 40: scala.meta.internal.semanticdb.RepeatedType.tpe:type_name -> scala.meta.internal.semanticdb.Type
-	27,  // 41: scala.meta.internal.semanticdb.Constant.unit_constant:type_name -> scala.meta.internal.semanticdb.UnitConstant
-	28,  // 42: scala.meta.internal.semanticdb.Constant.boolean_constant:type_name -> scala.meta.internal.semanticdb.BooleanConstant
-	29,  // 43: scala.meta.internal.semanticdb.Constant.byte_constant:type_name -> scala.meta.internal.semanticdb.ByteConstant
-	30,  // 44: scala.meta.internal.semanticdb.Constant.short_constant:type_name -> scala.meta.internal.semanticdb.ShortConstant
-	31,  // 45: scala.meta.internal.semanticdb.Constant.char_constant:type_name -> scala.meta.internal.semanticdb.CharConstant
-	32,  // 46: scala.meta.internal.semanticdb.Constant.int_constant:type_name -> scala.meta.internal.semanticdb.IntConstant
-	33,  // 47: scala.meta.internal.semanticdb.Constant.long_constant:type_name -> scala.meta.internal.semanticdb.LongConstant
-	34,  // 48: scala.meta.internal.semanticdb.Constant.float_constant:type_name -> scala.meta.internal.semanticdb.FloatConstant
-	35,  // 49: scala.meta.internal.semanticdb.Constant.double_constant:type_name -> scala.meta.internal.semanticdb.DoubleConstant
-	36,  // 50: scala.meta.internal.semanticdb.Constant.string_constant:type_name -> scala.meta.internal.semanticdb.StringConstant
-	37,  // 51: scala.meta.internal.semanticdb.Constant.null_constant:type_name -> scala.meta.internal.semanticdb.NullConstant
-	39,  // 52: scala.meta.internal.semanticdb.Signature.class_signature:type_name -> scala.meta.internal.semanticdb.ClassSignature
-	40,  // 53: scala.meta.internal.semanticdb.Signature.method_signature:type_name -> scala.meta.internal.semanticdb.MethodSignature
-	41,  // 54: scala.meta.internal.semanticdb.Signature.type_signature:type_name -> scala.meta.internal.semanticdb.TypeSignature
-	42,  // 55: scala.meta.internal.semanticdb.Signature.value_signature:type_name -> scala.meta.internal.semanticdb.ValueSignature
-	10,  // 56: scala.meta.internal.semanticdb.ClassSignature.type_parameters:type_name -> scala.meta.internal.semanticdb.Scope
-	11,  // 57: scala.meta.internal.semanticdb.ClassSignature.parents:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 58: scala.meta.internal.semanticdb.ClassSignature.self:type_name -> scala.meta.internal.semanticdb.Type
-	10,  // 59: scala.meta.internal.semanticdb.ClassSignature.declarations:type_name -> scala.meta.internal.semanticdb.Scope
-	10,  // 60: scala.meta.internal.semanticdb.MethodSignature.type_parameters:type_name -> scala.meta.internal.semanticdb.Scope
-	10,  // 61: scala.meta.internal.semanticdb.MethodSignature.parameter_lists:type_name -> scala.meta.internal.semanticdb.Scope
-	11,  // 62: scala.meta.internal.semanticdb.MethodSignature.return_type:type_name -> scala.meta.internal.semanticdb.Type
-	10,  // 63: scala.meta.internal.semanticdb.TypeSignature.type_parameters:type_name -> scala.meta.internal.semanticdb.Scope
-	11,  // 64: scala.meta.internal.semanticdb.TypeSignature.lower_bound:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 65: scala.meta.internal.semanticdb.TypeSignature.upper_bound:type_name -> scala.meta.internal.semanticdb.Type
-	11,  // 66: scala.meta.internal.semanticdb.ValueSignature.tpe:type_name -> scala.meta.internal.semanticdb.Type
-	1,   // 67: scala.meta.internal.semanticdb.SymbolInformation.language:type_name -> scala.meta.internal.semanticdb.Language
-	2,   // 68: scala.meta.internal.semanticdb.SymbolInformation.kind:type_name -> scala.meta.internal.semanticdb.SymbolInformation.Kind
-	38,  // 69: scala.meta.internal.semanticdb.SymbolInformation.signature:type_name -> scala.meta.internal.semanticdb.Signature
-	44,  // 70: scala.meta.internal.semanticdb.SymbolInformation.annotations:type_name -> scala.meta.internal.semanticdb.Annotation
-	45,  // 71: scala.meta.internal.semanticdb.SymbolInformation.access:type_name -> scala.meta.internal.semanticdb.Access
-	11,  // 72: scala.meta.internal.semanticdb.Annotation.tpe:type_name -> scala.meta.internal.semanticdb.Type
-	46,  // 73: scala.meta.internal.semanticdb.Access.private_access:type_name -> scala.meta.internal.semanticdb.PrivateAccess
-	47,  // 74: scala.meta.internal.semanticdb.Access.private_this_access:type_name -> scala.meta.internal.semanticdb.PrivateThisAccess
-	48,  // 75: scala.meta.internal.semanticdb.Access.private_within_access:type_name -> scala.meta.internal.semanticdb.PrivateWithinAccess
-	49,  // 76: scala.meta.internal.semanticdb.Access.protected_access:type_name -> scala.meta.internal.semanticdb.ProtectedAccess
-	50,  // 77: scala.meta.internal.semanticdb.Access.protected_this_access:type_name -> scala.meta.internal.semanticdb.ProtectedThisAccess
-	51,  // 78: scala.meta.internal.semanticdb.Access.protected_within_access:type_name -> scala.meta.internal.semanticdb.ProtectedWithinAccess
-	52,  // 79: scala.meta.internal.semanticdb.Access.public_access:type_name -> scala.meta.internal.semanticdb.PublicAccess
-	8,   // 80: scala.meta.internal.semanticdb.SymbolOccurrence.range:type_name -> scala.meta.internal.semanticdb.Range
-	4,   // 81: scala.meta.internal.semanticdb.SymbolOccurrence.role:type_name -> scala.meta.internal.semanticdb.SymbolOccurrence.Role
-	8,   // 82: scala.meta.internal.semanticdb.Diagnostic.range:type_name -> scala.meta.internal.semanticdb.Range
-	5,   // 83: scala.meta.internal.semanticdb.Diagnostic.severity:type_name -> scala.meta.internal.semanticdb.Diagnostic.Severity
-	8,   // 84: scala.meta.internal.semanticdb.Synthetic.range:type_name -> scala.meta.internal.semanticdb.Range
-	56,  // 85: scala.meta.internal.semanticdb.Synthetic.tree:type_name -> scala.meta.internal.semanticdb.Tree
-	57,  // 86: scala.meta.internal.semanticdb.Tree.apply_tree:type_name -> scala.meta.internal.semanticdb.ApplyTree
-	58,  // 87: scala.meta.internal.semanticdb.Tree.function_tree:type_name -> scala.meta.internal.semanticdb.FunctionTree
-	59,  // 88: scala.meta.internal.semanticdb.Tree.id_tree:type_name -> scala.meta.internal.semanticdb.IdTree
-	60,  // 89: scala.meta.internal.semanticdb.Tree.literal_tree:type_name -> scala.meta.internal.semanticdb.LiteralTree
-	61,  // 90: scala.meta.internal.semanticdb.Tree.macro_expansion_tree:type_name -> scala.meta.internal.semanticdb.MacroExpansionTree
-	62,  // 91: scala.meta.internal.semanticdb.Tree.original_tree:type_name -> scala.meta.internal.semanticdb.OriginalTree
-	63,  // 92: scala.meta.internal.semanticdb.Tree.select_tree:type_name -> scala.meta.internal.semanticdb.SelectTree
-	64,  // 93: scala.meta.internal.semanticdb.Tree.type_apply_tree:type_name -> scala.meta.internal.semanticdb.TypeApplyTree
-	56,  // 94: scala.meta.internal.semanticdb.ApplyTree.function:type_name -> scala.meta.internal.semanticdb.Tree
-	56,  // 95: scala.meta.internal.semanticdb.ApplyTree.arguments:type_name -> scala.meta.internal.semanticdb.Tree
-	59,  // 96: scala.meta.internal.semanticdb.FunctionTree.parameters:type_name -> scala.meta.internal.semanticdb.IdTree
-	56,  // 97: scala.meta.internal.semanticdb.FunctionTree.body:type_name -> scala.meta.internal.semanticdb.Tree
-	26,  // 98: scala.meta.internal.semanticdb.LiteralTree.constant:type_name -> scala.meta.internal.semanticdb.Constant
-	56,  // 99: scala.meta.internal.semanticdb.MacroExpansionTree.before_expansion:type_name -> scala.meta.internal.semanticdb.Tree
-	11,  // 100: scala.meta.internal.semanticdb.MacroExpansionTree.tpe:type_name -> scala.meta.internal.semanticdb.Type
-	8,   // 101: scala.meta.internal.semanticdb.OriginalTree.range:type_name -> scala.meta.internal.semanticdb.Range
-	56,  // 102: scala.meta.internal.semanticdb.SelectTree.qualifier:type_name -> scala.meta.internal.semanticdb.Tree
-	59,  // 103: scala.meta.internal.semanticdb.SelectTree.id:type_name -> scala.meta.internal.semanticdb.IdTree
-	56,  // 104: scala.meta.internal.semanticdb.TypeApplyTree.function:type_name -> scala.meta.internal.semanticdb.Tree
-	11,  // 105: scala.meta.internal.semanticdb.TypeApplyTree.type_arguments:type_name -> scala.meta.internal.semanticdb.Type
-	106, // [106:106] is the sub-list for method output_type
-	106, // [106:106] is the sub-list for method input_type
-	106, // [106:106] is the sub-list for extension type_name
-	106, // [106:106] is the sub-list for extension extendee
-	0,   // [0:106] is the sub-list for field type_name
+	46,  // 3: scala.meta.internal.semanticdb.TextDocument.symbols:type_name -> scala.meta.internal.semanticdb.SymbolInformation
+	57,  // 4: scala.meta.internal.semanticdb.TextDocument.occurrences:type_name -> scala.meta.internal.semanticdb.SymbolOccurrence
+	58,  // 5: scala.meta.internal.semanticdb.TextDocument.diagnostics:type_name -> scala.meta.internal.semanticdb.Diagnostic
+	59,  // 6: scala.meta.internal.semanticdb.TextDocument.synthetics:type_name -> scala.meta.internal.semanticdb.Synthetic
+	9,   // 7: scala.meta.internal.semanticdb.Location.range:type_name -> scala.meta.internal.semanticdb.Range
+	46,  // 8: scala.meta.internal.semanticdb.Scope.hardlinks:type_name -> scala.meta.internal.semanticdb.SymbolInformation
+	13,  // 9: scala.meta.internal.semanticdb.Type.type_ref:type_name -> scala.meta.internal.semanticdb.TypeRef
+	14,  // 10: scala.meta.internal.semanticdb.Type.single_type:type_name -> scala.meta.internal.semanticdb.SingleType
+	15,  // 11: scala.meta.internal.semanticdb.Type.this_type:type_name -> scala.meta.internal.semanticdb.ThisType
+	16,  // 12: scala.meta.internal.semanticdb.Type.super_type:type_name -> scala.meta.internal.semanticdb.SuperType
+	17,  // 13: scala.meta.internal.semanticdb.Type.constant_type:type_name -> scala.meta.internal.semanticdb.ConstantType
+	18,  // 14: scala.meta.internal.semanticdb.Type.intersection_type:type_name -> scala.meta.internal.semanticdb.IntersectionType
+	19,  // 15: scala.meta.internal.semanticdb.Type.union_type:type_name -> scala.meta.internal.semanticdb.UnionType
+	20,  // 16: scala.meta.internal.semanticdb.Type.with_type:type_name -> scala.meta.internal.semanticdb.WithType
+	21,  // 17: scala.meta.internal.semanticdb.Type.structural_type:type_name -> scala.meta.internal.semanticdb.StructuralType
+	22,  // 18: scala.meta.internal.semanticdb.Type.annotated_type:type_name -> scala.meta.internal.semanticdb.AnnotatedType
+	23,  // 19: scala.meta.internal.semanticdb.Type.existential_type:type_name -> scala.meta.internal.semanticdb.ExistentialType
+	24,  // 20: scala.meta.internal.semanticdb.Type.universal_type:type_name -> scala.meta.internal.semanticdb.UniversalType
+	25,  // 21: scala.meta.internal.semanticdb.Type.by_name_type:type_name -> scala.meta.internal.semanticdb.ByNameType
+	26,  // 22: scala.meta.internal.semanticdb.Type.repeated_type:type_name -> scala.meta.internal.semanticdb.RepeatedType
+	27,  // 23: scala.meta.internal.semanticdb.Type.match_type:type_name -> scala.meta.internal.semanticdb.MatchType
+	28,  // 24: scala.meta.internal.semanticdb.Type.lambda_type:type_name -> scala.meta.internal.semanticdb.LambdaType
+	12,  // 25: scala.meta.internal.semanticdb.TypeRef.prefix:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 26: scala.meta.internal.semanticdb.TypeRef.type_arguments:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 27: scala.meta.internal.semanticdb.SingleType.prefix:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 28: scala.meta.internal.semanticdb.SuperType.prefix:type_name -> scala.meta.internal.semanticdb.Type
+	29,  // 29: scala.meta.internal.semanticdb.ConstantType.constant:type_name -> scala.meta.internal.semanticdb.Constant
+	12,  // 30: scala.meta.internal.semanticdb.IntersectionType.types:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 31: scala.meta.internal.semanticdb.UnionType.types:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 32: scala.meta.internal.semanticdb.WithType.types:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 33: scala.meta.internal.semanticdb.StructuralType.tpe:type_name -> scala.meta.internal.semanticdb.Type
+	11,  // 34: scala.meta.internal.semanticdb.StructuralType.declarations:type_name -> scala.meta.internal.semanticdb.Scope
+	48,  // 35: scala.meta.internal.semanticdb.AnnotatedType.annotations:type_name -> scala.meta.internal.semanticdb.Annotation
+	12,  // 36: scala.meta.internal.semanticdb.AnnotatedType.tpe:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 37: scala.meta.internal.semanticdb.ExistentialType.tpe:type_name -> scala.meta.internal.semanticdb.Type
+	11,  // 38: scala.meta.internal.semanticdb.ExistentialType.declarations:type_name -> scala.meta.internal.semanticdb.Scope
+	11,  // 39: scala.meta.internal.semanticdb.UniversalType.type_parameters:type_name -> scala.meta.internal.semanticdb.Scope
+	12,  // 40: scala.meta.internal.semanticdb.UniversalType.tpe:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 41: scala.meta.internal.semanticdb.ByNameType.tpe:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 42: scala.meta.internal.semanticdb.RepeatedType.tpe:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 43: scala.meta.internal.semanticdb.MatchType.scrutinee:type_name -> scala.meta.internal.semanticdb.Type
+	69,  // 44: scala.meta.internal.semanticdb.MatchType.cases:type_name -> scala.meta.internal.semanticdb.MatchType.CaseType
+	11,  // 45: scala.meta.internal.semanticdb.LambdaType.parameters:type_name -> scala.meta.internal.semanticdb.Scope
+	12,  // 46: scala.meta.internal.semanticdb.LambdaType.return_type:type_name -> scala.meta.internal.semanticdb.Type
+	30,  // 47: scala.meta.internal.semanticdb.Constant.unit_constant:type_name -> scala.meta.internal.semanticdb.UnitConstant
+	31,  // 48: scala.meta.internal.semanticdb.Constant.boolean_constant:type_name -> scala.meta.internal.semanticdb.BooleanConstant
+	32,  // 49: scala.meta.internal.semanticdb.Constant.byte_constant:type_name -> scala.meta.internal.semanticdb.ByteConstant
+	33,  // 50: scala.meta.internal.semanticdb.Constant.short_constant:type_name -> scala.meta.internal.semanticdb.ShortConstant
+	34,  // 51: scala.meta.internal.semanticdb.Constant.char_constant:type_name -> scala.meta.internal.semanticdb.CharConstant
+	35,  // 52: scala.meta.internal.semanticdb.Constant.int_constant:type_name -> scala.meta.internal.semanticdb.IntConstant
+	36,  // 53: scala.meta.internal.semanticdb.Constant.long_constant:type_name -> scala.meta.internal.semanticdb.LongConstant
+	37,  // 54: scala.meta.internal.semanticdb.Constant.float_constant:type_name -> scala.meta.internal.semanticdb.FloatConstant
+	38,  // 55: scala.meta.internal.semanticdb.Constant.double_constant:type_name -> scala.meta.internal.semanticdb.DoubleConstant
+	39,  // 56: scala.meta.internal.semanticdb.Constant.string_constant:type_name -> scala.meta.internal.semanticdb.StringConstant
+	40,  // 57: scala.meta.internal.semanticdb.Constant.null_constant:type_name -> scala.meta.internal.semanticdb.NullConstant
+	42,  // 58: scala.meta.internal.semanticdb.Signature.class_signature:type_name -> scala.meta.internal.semanticdb.ClassSignature
+	43,  // 59: scala.meta.internal.semanticdb.Signature.method_signature:type_name -> scala.meta.internal.semanticdb.MethodSignature
+	44,  // 60: scala.meta.internal.semanticdb.Signature.type_signature:type_name -> scala.meta.internal.semanticdb.TypeSignature
+	45,  // 61: scala.meta.internal.semanticdb.Signature.value_signature:type_name -> scala.meta.internal.semanticdb.ValueSignature
+	11,  // 62: scala.meta.internal.semanticdb.ClassSignature.type_parameters:type_name -> scala.meta.internal.semanticdb.Scope
+	12,  // 63: scala.meta.internal.semanticdb.ClassSignature.parents:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 64: scala.meta.internal.semanticdb.ClassSignature.self:type_name -> scala.meta.internal.semanticdb.Type
+	11,  // 65: scala.meta.internal.semanticdb.ClassSignature.declarations:type_name -> scala.meta.internal.semanticdb.Scope
+	11,  // 66: scala.meta.internal.semanticdb.MethodSignature.type_parameters:type_name -> scala.meta.internal.semanticdb.Scope
+	11,  // 67: scala.meta.internal.semanticdb.MethodSignature.parameter_lists:type_name -> scala.meta.internal.semanticdb.Scope
+	12,  // 68: scala.meta.internal.semanticdb.MethodSignature.return_type:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 69: scala.meta.internal.semanticdb.MethodSignature.throws:type_name -> scala.meta.internal.semanticdb.Type
+	11,  // 70: scala.meta.internal.semanticdb.TypeSignature.type_parameters:type_name -> scala.meta.internal.semanticdb.Scope
+	12,  // 71: scala.meta.internal.semanticdb.TypeSignature.lower_bound:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 72: scala.meta.internal.semanticdb.TypeSignature.upper_bound:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 73: scala.meta.internal.semanticdb.ValueSignature.tpe:type_name -> scala.meta.internal.semanticdb.Type
+	1,   // 74: scala.meta.internal.semanticdb.SymbolInformation.language:type_name -> scala.meta.internal.semanticdb.Language
+	2,   // 75: scala.meta.internal.semanticdb.SymbolInformation.kind:type_name -> scala.meta.internal.semanticdb.SymbolInformation.Kind
+	41,  // 76: scala.meta.internal.semanticdb.SymbolInformation.signature:type_name -> scala.meta.internal.semanticdb.Signature
+	48,  // 77: scala.meta.internal.semanticdb.SymbolInformation.annotations:type_name -> scala.meta.internal.semanticdb.Annotation
+	49,  // 78: scala.meta.internal.semanticdb.SymbolInformation.access:type_name -> scala.meta.internal.semanticdb.Access
+	47,  // 79: scala.meta.internal.semanticdb.SymbolInformation.documentation:type_name -> scala.meta.internal.semanticdb.Documentation
+	4,   // 80: scala.meta.internal.semanticdb.Documentation.format:type_name -> scala.meta.internal.semanticdb.Documentation.Format
+	12,  // 81: scala.meta.internal.semanticdb.Annotation.tpe:type_name -> scala.meta.internal.semanticdb.Type
+	50,  // 82: scala.meta.internal.semanticdb.Access.private_access:type_name -> scala.meta.internal.semanticdb.PrivateAccess
+	51,  // 83: scala.meta.internal.semanticdb.Access.private_this_access:type_name -> scala.meta.internal.semanticdb.PrivateThisAccess
+	52,  // 84: scala.meta.internal.semanticdb.Access.private_within_access:type_name -> scala.meta.internal.semanticdb.PrivateWithinAccess
+	53,  // 85: scala.meta.internal.semanticdb.Access.protected_access:type_name -> scala.meta.internal.semanticdb.ProtectedAccess
+	54,  // 86: scala.meta.internal.semanticdb.Access.protected_this_access:type_name -> scala.meta.internal.semanticdb.ProtectedThisAccess
+	55,  // 87: scala.meta.internal.semanticdb.Access.protected_within_access:type_name -> scala.meta.internal.semanticdb.ProtectedWithinAccess
+	56,  // 88: scala.meta.internal.semanticdb.Access.public_access:type_name -> scala.meta.internal.semanticdb.PublicAccess
+	9,   // 89: scala.meta.internal.semanticdb.SymbolOccurrence.range:type_name -> scala.meta.internal.semanticdb.Range
+	5,   // 90: scala.meta.internal.semanticdb.SymbolOccurrence.role:type_name -> scala.meta.internal.semanticdb.SymbolOccurrence.Role
+	9,   // 91: scala.meta.internal.semanticdb.Diagnostic.range:type_name -> scala.meta.internal.semanticdb.Range
+	6,   // 92: scala.meta.internal.semanticdb.Diagnostic.severity:type_name -> scala.meta.internal.semanticdb.Diagnostic.Severity
+	9,   // 93: scala.meta.internal.semanticdb.Synthetic.range:type_name -> scala.meta.internal.semanticdb.Range
+	60,  // 94: scala.meta.internal.semanticdb.Synthetic.tree:type_name -> scala.meta.internal.semanticdb.Tree
+	61,  // 95: scala.meta.internal.semanticdb.Tree.apply_tree:type_name -> scala.meta.internal.semanticdb.ApplyTree
+	62,  // 96: scala.meta.internal.semanticdb.Tree.function_tree:type_name -> scala.meta.internal.semanticdb.FunctionTree
+	63,  // 97: scala.meta.internal.semanticdb.Tree.id_tree:type_name -> scala.meta.internal.semanticdb.IdTree
+	64,  // 98: scala.meta.internal.semanticdb.Tree.literal_tree:type_name -> scala.meta.internal.semanticdb.LiteralTree
+	65,  // 99: scala.meta.internal.semanticdb.Tree.macro_expansion_tree:type_name -> scala.meta.internal.semanticdb.MacroExpansionTree
+	66,  // 100: scala.meta.internal.semanticdb.Tree.original_tree:type_name -> scala.meta.internal.semanticdb.OriginalTree
+	67,  // 101: scala.meta.internal.semanticdb.Tree.select_tree:type_name -> scala.meta.internal.semanticdb.SelectTree
+	68,  // 102: scala.meta.internal.semanticdb.Tree.type_apply_tree:type_name -> scala.meta.internal.semanticdb.TypeApplyTree
+	60,  // 103: scala.meta.internal.semanticdb.ApplyTree.function:type_name -> scala.meta.internal.semanticdb.Tree
+	60,  // 104: scala.meta.internal.semanticdb.ApplyTree.arguments:type_name -> scala.meta.internal.semanticdb.Tree
+	63,  // 105: scala.meta.internal.semanticdb.FunctionTree.parameters:type_name -> scala.meta.internal.semanticdb.IdTree
+	60,  // 106: scala.meta.internal.semanticdb.FunctionTree.body:type_name -> scala.meta.internal.semanticdb.Tree
+	29,  // 107: scala.meta.internal.semanticdb.LiteralTree.constant:type_name -> scala.meta.internal.semanticdb.Constant
+	60,  // 108: scala.meta.internal.semanticdb.MacroExpansionTree.before_expansion:type_name -> scala.meta.internal.semanticdb.Tree
+	12,  // 109: scala.meta.internal.semanticdb.MacroExpansionTree.tpe:type_name -> scala.meta.internal.semanticdb.Type
+	9,   // 110: scala.meta.internal.semanticdb.OriginalTree.range:type_name -> scala.meta.internal.semanticdb.Range
+	60,  // 111: scala.meta.internal.semanticdb.SelectTree.qualifier:type_name -> scala.meta.internal.semanticdb.Tree
+	63,  // 112: scala.meta.internal.semanticdb.SelectTree.id:type_name -> scala.meta.internal.semanticdb.IdTree
+	60,  // 113: scala.meta.internal.semanticdb.TypeApplyTree.function:type_name -> scala.meta.internal.semanticdb.Tree
+	12,  // 114: scala.meta.internal.semanticdb.TypeApplyTree.type_arguments:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 115: scala.meta.internal.semanticdb.MatchType.CaseType.key:type_name -> scala.meta.internal.semanticdb.Type
+	12,  // 116: scala.meta.internal.semanticdb.MatchType.CaseType.body:type_name -> scala.meta.internal.semanticdb.Type
+	117, // [117:117] is the sub-list for method output_type
+	117, // [117:117] is the sub-list for method input_type
+	117, // [117:117] is the sub-list for extension type_name
+	117, // [117:117] is the sub-list for extension extendee
+	0,   // [0:117] is the sub-list for field type_name
 }
 
 func init() { file_semanticdb_proto_init() }
@@ -5235,7 +5658,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Constant); i {
+			switch v := v.(*MatchType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5247,7 +5670,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnitConstant); i {
+			switch v := v.(*LambdaType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5259,7 +5682,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BooleanConstant); i {
+			switch v := v.(*Constant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5271,7 +5694,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ByteConstant); i {
+			switch v := v.(*UnitConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5283,7 +5706,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortConstant); i {
+			switch v := v.(*BooleanConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5295,7 +5718,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CharConstant); i {
+			switch v := v.(*ByteConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5307,7 +5730,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntConstant); i {
+			switch v := v.(*ShortConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5319,7 +5742,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LongConstant); i {
+			switch v := v.(*CharConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5331,7 +5754,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FloatConstant); i {
+			switch v := v.(*IntConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5343,7 +5766,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DoubleConstant); i {
+			switch v := v.(*LongConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5355,7 +5778,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringConstant); i {
+			switch v := v.(*FloatConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5367,7 +5790,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NullConstant); i {
+			switch v := v.(*DoubleConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5379,7 +5802,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Signature); i {
+			switch v := v.(*StringConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5391,7 +5814,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClassSignature); i {
+			switch v := v.(*NullConstant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5403,7 +5826,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MethodSignature); i {
+			switch v := v.(*Signature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5415,7 +5838,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeSignature); i {
+			switch v := v.(*ClassSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5427,7 +5850,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValueSignature); i {
+			switch v := v.(*MethodSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5439,7 +5862,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SymbolInformation); i {
+			switch v := v.(*TypeSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5451,7 +5874,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Annotation); i {
+			switch v := v.(*ValueSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5463,7 +5886,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Access); i {
+			switch v := v.(*SymbolInformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5475,7 +5898,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivateAccess); i {
+			switch v := v.(*Documentation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5487,7 +5910,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivateThisAccess); i {
+			switch v := v.(*Annotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5499,7 +5922,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivateWithinAccess); i {
+			switch v := v.(*Access); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5511,7 +5934,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtectedAccess); i {
+			switch v := v.(*PrivateAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5523,7 +5946,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtectedThisAccess); i {
+			switch v := v.(*PrivateThisAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5535,7 +5958,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtectedWithinAccess); i {
+			switch v := v.(*PrivateWithinAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5547,7 +5970,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublicAccess); i {
+			switch v := v.(*ProtectedAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5559,7 +5982,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SymbolOccurrence); i {
+			switch v := v.(*ProtectedThisAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5571,7 +5994,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Diagnostic); i {
+			switch v := v.(*ProtectedWithinAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5583,7 +6006,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Synthetic); i {
+			switch v := v.(*PublicAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5595,7 +6018,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tree); i {
+			switch v := v.(*SymbolOccurrence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5607,7 +6030,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyTree); i {
+			switch v := v.(*Diagnostic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5619,7 +6042,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FunctionTree); i {
+			switch v := v.(*Synthetic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5631,7 +6054,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IdTree); i {
+			switch v := v.(*Tree); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5643,7 +6066,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LiteralTree); i {
+			switch v := v.(*ApplyTree); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5655,7 +6078,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MacroExpansionTree); i {
+			switch v := v.(*FunctionTree); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5667,7 +6090,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OriginalTree); i {
+			switch v := v.(*IdTree); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5679,7 +6102,7 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelectTree); i {
+			switch v := v.(*LiteralTree); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5691,6 +6114,42 @@ func file_semanticdb_proto_init() {
 			}
 		}
 		file_semanticdb_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacroExpansionTree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_semanticdb_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OriginalTree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_semanticdb_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectTree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_semanticdb_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TypeApplyTree); i {
 			case 0:
 				return &v.state
@@ -5702,6 +6161,18 @@ func file_semanticdb_proto_init() {
 				return nil
 			}
 		}
+		file_semanticdb_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchType_CaseType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_semanticdb_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*Type_TypeRef)(nil),
@@ -5718,8 +6189,10 @@ func file_semanticdb_proto_init() {
 		(*Type_UniversalType)(nil),
 		(*Type_ByNameType)(nil),
 		(*Type_RepeatedType)(nil),
+		(*Type_MatchType)(nil),
+		(*Type_LambdaType)(nil),
 	}
-	file_semanticdb_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_semanticdb_proto_msgTypes[22].OneofWrappers = []interface{}{
 		(*Constant_UnitConstant)(nil),
 		(*Constant_BooleanConstant)(nil),
 		(*Constant_ByteConstant)(nil),
@@ -5732,13 +6205,13 @@ func file_semanticdb_proto_init() {
 		(*Constant_StringConstant)(nil),
 		(*Constant_NullConstant)(nil),
 	}
-	file_semanticdb_proto_msgTypes[32].OneofWrappers = []interface{}{
+	file_semanticdb_proto_msgTypes[34].OneofWrappers = []interface{}{
 		(*Signature_ClassSignature)(nil),
 		(*Signature_MethodSignature)(nil),
 		(*Signature_TypeSignature)(nil),
 		(*Signature_ValueSignature)(nil),
 	}
-	file_semanticdb_proto_msgTypes[39].OneofWrappers = []interface{}{
+	file_semanticdb_proto_msgTypes[42].OneofWrappers = []interface{}{
 		(*Access_PrivateAccess)(nil),
 		(*Access_PrivateThisAccess)(nil),
 		(*Access_PrivateWithinAccess)(nil),
@@ -5747,7 +6220,7 @@ func file_semanticdb_proto_init() {
 		(*Access_ProtectedWithinAccess)(nil),
 		(*Access_PublicAccess)(nil),
 	}
-	file_semanticdb_proto_msgTypes[50].OneofWrappers = []interface{}{
+	file_semanticdb_proto_msgTypes[53].OneofWrappers = []interface{}{
 		(*Tree_ApplyTree)(nil),
 		(*Tree_FunctionTree)(nil),
 		(*Tree_IdTree)(nil),
@@ -5762,8 +6235,8 @@ func file_semanticdb_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_semanticdb_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   59,
+			NumEnums:      7,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,136 @@
+package semanticdb
+
+import "strings"
+
+// Suffix identifies the kind of a descriptor.
+type Suffix int
+
+// Descriptor suffixes as defined by the SemanticDB specification.
+const (
+	NoSuffix Suffix = iota
+	PackageSuffix
+	TermSuffix
+	TypeSuffix
+	MethodSuffix
+	ParameterSuffix
+	TypeParameterSuffix
+)
+
+// Descriptor is the last component of a global symbol.
+type Descriptor struct {
+	Name   string
+	Suffix Suffix
+
+	// Disambiguator distinguishes overloaded methods, e.g. "()" or "(+1)"
+	Disambiguator string
+}
+
+// String formats the descriptor as it appears in a symbol.
+func (d Descriptor) String() string {
+	name := escapeName(d.Name)
+
+	switch d.Suffix {
+	case PackageSuffix:
+		return name + "/"
+	case TermSuffix:
+		return name + "."
+	case TypeSuffix:
+		return name + "#"
+	case MethodSuffix:
+		return name + d.Disambiguator + "."
+	case ParameterSuffix:
+		return "(" + name + ")"
+	case TypeParameterSuffix:
+		return "[" + name + "]"
+	}
+
+	return name
+}
+
+// ParseSymbol splits a global symbol into its owner and its last descriptor.
+// It returns false for local symbols and malformed input.
+func ParseSymbol(symbol string) (owner string, descriptor Descriptor, ok bool) {
+	n := len(symbol)
+	if n < 2 || IsLocal(symbol) {
+		return "", Descriptor{}, false
+	}
+
+	var nameEnd int
+	switch symbol[n-1] {
+	case '/':
+		descriptor.Suffix, nameEnd = PackageSuffix, n-1
+	case '#':
+		descriptor.Suffix, nameEnd = TypeSuffix, n-1
+	case '.':
+		if symbol[n-2] == ')' {
+			start := strings.LastIndexByte(symbol[:n-1], '(')
+			if start < 0 {
+				return "", Descriptor{}, false
+			}
+			descriptor.Suffix, descriptor.Disambiguator, nameEnd = MethodSuffix, symbol[start:n-1], start
+		} else {
+			descriptor.Suffix, nameEnd = TermSuffix, n-1
+		}
+	case ')':
+		return parseEnclosed(symbol, ParameterSuffix, '(')
+	case ']':
+		return parseEnclosed(symbol, TypeParameterSuffix, '[')
+	default:
+		return "", Descriptor{}, false
+	}
+
+	name, start, ok := readName(symbol, nameEnd)
+	if !ok {
+		return "", Descriptor{}, false
+	}
+
+	descriptor.Name = name
+	return symbol[:start], descriptor, true
+}
+
+// parseEnclosed parses parameter and type parameter descriptors.
+func parseEnclosed(symbol string, suffix Suffix, open byte) (string, Descriptor, bool) {
+	name, start, ok := readName(symbol, len(symbol)-1)
+	if !ok || start == 0 || symbol[start-1] != open {
+		return "", Descriptor{}, false
+	}
+
+	return symbol[:start-1], Descriptor{Name: name, Suffix: suffix}, true
+}
+
+// readName reads the possibly backticked name ending before index end and
+// returns it along with its start index.
+func readName(symbol string, end int) (string, int, bool) {
+	if end > 0 && symbol[end-1] == '`' {
+		start := strings.LastIndexByte(symbol[:end-1], '`')
+		if start < 0 {
+			return "", 0, false
+		}
+		return symbol[start+1 : end-1], start, true
+	}
+
+	start := end
+	for start > 0 && !strings.ContainsRune("/.#()[]`", rune(symbol[start-1])) {
+		start--
+	}
+	if start == end {
+		return "", 0, false
+	}
+
+	return symbol[start:end], start, true
+}
+
+// escapeName wraps names that are not plain identifiers in backticks.
+func escapeName(name string) string {
+	if name == "" {
+		return "``"
+	}
+
+	for _, r := range name {
+		if !(r == '_' || r == '$' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r > 0x7f) {
+			return "`" + name + "`"
+		}
+	}
+
+	return name
+}
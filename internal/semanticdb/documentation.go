@@ -0,0 +1,80 @@
+package semanticdb
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+// DocumentationMarkdown returns the documentation of a symbol as Markdown.
+// Markdown is returned unchanged and HTML is converted to Markdown. Javadoc,
+// Scaladoc and KDoc markup would be misread as Markdown, so documentation in
+// these formats is returned as a code block.
+func DocumentationMarkdown(documentation *pb.Documentation) string {
+	message := strings.TrimSpace(documentation.GetMessage())
+	if message == "" {
+		return ""
+	}
+
+	switch documentation.GetFormat() {
+	case pb.Documentation_MARKDOWN:
+		return message
+	case pb.Documentation_HTML:
+		return htmlToMarkdown(message)
+	default:
+		return fence(message)
+	}
+}
+
+var (
+	htmlPre   = regexp.MustCompile(`(?is)<pre\b[^>]*>(.*?)</pre>`)
+	htmlCode  = regexp.MustCompile(`(?is)<(?:code|tt)\b[^>]*>(.*?)</(?:code|tt)>`)
+	htmlBold  = regexp.MustCompile(`(?is)<(?:b|strong)\b[^>]*>(.*?)</(?:b|strong)>`)
+	htmlItal  = regexp.MustCompile(`(?is)<(?:i|em)\b[^>]*>(.*?)</(?:i|em)>`)
+	htmlItem  = regexp.MustCompile(`(?i)<li\b[^>]*>`)
+	htmlBreak = regexp.MustCompile(`(?i)<br\s*/?>`)
+	htmlBlock = regexp.MustCompile(`(?i)</?(?:p|div|ul|ol|h[1-6])\b[^>]*>`)
+	// Other tags of the HTML subset found in documentation comments. Anything
+	// else in angle brackets, e.g. List<String>, is kept.
+	htmlTag    = regexp.MustCompile(`(?i)</?(?:a|span|li|blockquote|sup|sub|u)\b[^>]*>`)
+	blankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToMarkdown converts the HTML commonly found in documentation comments
+// to Markdown.
+func htmlToMarkdown(s string) string {
+	// Preformatted blocks are replaced by placeholders while the rest of the
+	// text is converted
+	var blocks []string
+	s = htmlPre.ReplaceAllStringFunc(s, func(pre string) string {
+		blocks = append(blocks, fence(html.UnescapeString(htmlPre.FindStringSubmatch(pre)[1])))
+		return "\n\n\x00" + strconv.Itoa(len(blocks)-1) + "\x00\n\n"
+	})
+
+	s = htmlCode.ReplaceAllString(s, "`$1`")
+	s = htmlBold.ReplaceAllString(s, "**$1**")
+	s = htmlItal.ReplaceAllString(s, "*$1*")
+	s = htmlItem.ReplaceAllString(s, "\n- ")
+	s = htmlBreak.ReplaceAllString(s, "  \n")
+	s = htmlBlock.ReplaceAllString(s, "\n\n")
+	s = htmlTag.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	s = strings.TrimSpace(blankLines.ReplaceAllString(s, "\n\n"))
+
+	for i, block := range blocks {
+		s = strings.Replace(s, "\x00"+strconv.Itoa(i)+"\x00", block, 1)
+	}
+	return s
+}
+
+// fence returns a Markdown code block containing the text verbatim.
+func fence(text string) string {
+	delimiter := "```"
+	for strings.Contains(text, delimiter) {
+		delimiter += "`"
+	}
+	return delimiter + "\n" + strings.Trim(text, "\r\n") + "\n" + delimiter
+}
@@ -0,0 +1,37 @@
+package semanticdb
+
+import (
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+func TestDocumentationMarkdown(t *testing.T) {
+	testCases := []struct {
+		format  pb.Documentation_Format
+		message string
+		want    string
+	}{
+		{pb.Documentation_MARKDOWN, "Returns *foo*.\n", "Returns *foo*."},
+		{pb.Documentation_MARKDOWN, "  ", ""},
+		{pb.Documentation_HTML, "Returns a <code>List&lt;T&gt;</code>.", "Returns a `List<T>`."},
+		{pb.Documentation_HTML, "A <b>bold</b> and <em>emphasized</em> List<String>.", "A **bold** and *emphasized* List<String>."},
+		{pb.Documentation_HTML, "<p>First.</p>\n\n\n<p>Second<br>line, see <a href=\"#x\">x</a>.</p>", "First.\n\nSecond  \nline, see x."},
+		{pb.Documentation_HTML, "Items:<ul><li>one</li><li>two</li></ul>", "Items:\n\n- one\n- two"},
+		{pb.Documentation_HTML, "Example:<pre>\n  if (a &lt; b) {\n\n\n    <b>f</b>();\n  }\n</pre>Done.", "Example:\n\n```\n  if (a < b) {\n\n\n    <b>f</b>();\n  }\n```\n\nDone."},
+		{pb.Documentation_JAVADOC, "Returns {@code foo}.\n@return *foo*", "```\nReturns {@code foo}.\n@return *foo*\n```"},
+		{pb.Documentation_SCALADOC, "Use {{{\n```\n}}}", "````\nUse {{{\n```\n}}}\n````"},
+		{pb.Documentation_KDOC, "Returns [foo].", "```\nReturns [foo].\n```"},
+	}
+
+	for _, testCase := range testCases {
+		documentation := &pb.Documentation{Message: testCase.message, Format: testCase.format}
+		if got := DocumentationMarkdown(documentation); got != testCase.want {
+			t.Errorf("DocumentationMarkdown(%s %q) = %q, want %q", testCase.format, testCase.message, got, testCase.want)
+		}
+	}
+
+	if got := DocumentationMarkdown(nil); got != "" {
+		t.Errorf("DocumentationMarkdown(nil) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,181 @@
+// Package semanticdb reads SemanticDB files and interprets their symbols.
+package semanticdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// Extensions of SemanticDB files in each format.
+const (
+	Extension          = ".semanticdb"
+	TextprotoExtension = ".semanticdb.textproto"
+	JSONExtension      = ".semanticdb.json"
+)
+
+// Format is the encoding of a SemanticDB file.
+type Format int
+
+// Formats of SemanticDB files. Binary files are written by the compiler
+// plugins; the text formats are meant to be written and read by people.
+const (
+	Binary Format = iota
+	Textproto
+	JSON
+)
+
+// ParseFormat returns the Format with the given name.
+func ParseFormat(name string) (Format, error) {
+	switch name {
+	case "binary":
+		return Binary, nil
+	case "textproto":
+		return Textproto, nil
+	case "json":
+		return JSON, nil
+	}
+
+	return 0, fmt.Errorf("unknown SemanticDB format %q", name)
+}
+
+// FormatOf returns the format of the SemanticDB file with the given path
+// according to its extension. It returns false if the path does not name a
+// SemanticDB file.
+func FormatOf(path string) (Format, bool) {
+	switch {
+	case strings.HasSuffix(path, Extension):
+		return Binary, true
+	case strings.HasSuffix(path, TextprotoExtension):
+		return Textproto, true
+	case strings.HasSuffix(path, JSONExtension):
+		return JSON, true
+	}
+
+	return 0, false
+}
+
+// IsFile returns true if the path names a SemanticDB file in any format.
+func IsFile(path string) bool {
+	_, ok := FormatOf(path)
+	return ok
+}
+
+// ReadFile decodes the SemanticDB file at the given path in the format
+// indicated by its extension.
+func ReadFile(path string) (*pb.TextDocuments, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	format, _ := FormatOf(path)
+	return Unmarshal(contents, format)
+}
+
+// Unmarshal decodes SemanticDB documents in the given format.
+func Unmarshal(contents []byte, format Format) (*pb.TextDocuments, error) {
+	textDocuments := &pb.TextDocuments{}
+
+	var err error
+	switch format {
+	case Textproto:
+		err = prototext.Unmarshal(contents, textDocuments)
+	case JSON:
+		err = protojson.Unmarshal(contents, textDocuments)
+	default:
+		err = proto.Unmarshal(contents, textDocuments)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return textDocuments, nil
+}
+
+// Marshal encodes SemanticDB documents in the given format. The text formats
+// are indented.
+func Marshal(textDocuments *pb.TextDocuments, format Format) ([]byte, error) {
+	switch format {
+	case Textproto:
+		return prototext.MarshalOptions{Multiline: true}.Marshal(textDocuments)
+	case JSON:
+		return protojson.MarshalOptions{Multiline: true}.Marshal(textDocuments)
+	}
+
+	return proto.Marshal(textDocuments)
+}
+
+// DocumentURI returns the URI of the document that the compiler plugins write
+// to the SemanticDB file at the given path: the slash-separated path below the
+// META-INF/semanticdb directory without the SemanticDB extension. It returns
+// false if the path is not below such a directory.
+func DocumentURI(path string) (string, bool) {
+	path = filepath.ToSlash(path)
+
+	const dir = "META-INF/semanticdb/"
+	i := strings.LastIndex(path, "/"+dir)
+	if i < 0 {
+		if !strings.HasPrefix(path, dir) {
+			return "", false
+		}
+		i = -1
+	}
+	uri := path[i+1+len(dir):]
+
+	for _, extension := range []string{TextprotoExtension, JSONExtension, Extension} {
+		if strings.HasSuffix(uri, extension) {
+			return strings.TrimSuffix(uri, extension), true
+		}
+	}
+
+	return "", false
+}
+
+// Walk decodes every SemanticDB file found in the given directories, in any
+// format, and calls fn with its path and documents.
+func Walk(dirs []string, fn func(path string, textDocuments *pb.TextDocuments) error) error {
+	return WalkSelected(dirs, nil, fn)
+}
+
+// WalkSelected is like Walk, but skips the SemanticDB files for which selected
+// returns false without reading them. A nil selected function selects every
+// file.
+func WalkSelected(dirs []string, selected func(path string) bool, fn func(path string, textDocuments *pb.TextDocuments) error) error {
+	for _, dir := range dirs {
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if info.IsDir() || !IsFile(path) {
+				return nil
+			}
+			if selected != nil && !selected(path) {
+				return nil
+			}
+
+			textDocuments, err := ReadFile(path)
+			if err == nil {
+				err = fn(path, textDocuments)
+			}
+			if err != nil {
+				return fmt.Errorf("load database %s: %v", path, err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("load databases: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package semanticdb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+var testDocuments = &pb.TextDocuments{
+	Documents: []*pb.TextDocument{
+		{
+			Schema:   pb.Schema_SEMANTICDB4,
+			Uri:      "src/A.scala",
+			Text:     "object A",
+			Language: pb.Language_SCALA,
+			Symbols: []*pb.SymbolInformation{
+				{Symbol: "a/A.", Kind: pb.SymbolInformation_OBJECT, DisplayName: "A"},
+			},
+			Occurrences: []*pb.SymbolOccurrence{
+				{Range: &pb.Range{StartCharacter: 7, EndCharacter: 8}, Symbol: "a/A.", Role: pb.SymbolOccurrence_DEFINITION},
+			},
+		},
+	},
+}
+
+func TestFormatOf(t *testing.T) {
+	testCases := []struct {
+		path   string
+		format Format
+		ok     bool
+	}{
+		{"a/A.scala.semanticdb", Binary, true},
+		{"a/A.scala.semanticdb.textproto", Textproto, true},
+		{"a/A.scala.semanticdb.json", JSON, true},
+		{"a/A.scala", Binary, false},
+		{"a/A.textproto", Binary, false},
+		{"", Binary, false},
+	}
+
+	for _, testCase := range testCases {
+		if format, ok := FormatOf(testCase.path); format != testCase.format || ok != testCase.ok {
+			t.Errorf("FormatOf(%q) = %v, %v, want %v, %v", testCase.path, format, ok, testCase.format, testCase.ok)
+		}
+		if IsFile(testCase.path) != testCase.ok {
+			t.Errorf("IsFile(%q) = %v, want %v", testCase.path, !testCase.ok, testCase.ok)
+		}
+	}
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	for _, format := range []Format{Binary, Textproto, JSON} {
+		contents, err := Marshal(testDocuments, format)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", format, err)
+		}
+
+		got, err := Unmarshal(contents, format)
+		if err != nil {
+			t.Fatalf("Unmarshal(%v): %v", format, err)
+		}
+		if !proto.Equal(got, testDocuments) {
+			t.Errorf("Unmarshal(Marshal(%v)) = %v, want %v", format, got, testDocuments)
+		}
+	}
+
+	for _, format := range []Format{Binary, Textproto, JSON} {
+		if _, err := Unmarshal([]byte("{ invalid"), format); err == nil {
+			t.Errorf("expected an error decoding invalid contents as %v", format)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	binary, err := Marshal(testDocuments, Binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := Unmarshal(binary, Binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := Marshal(decoded, Textproto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err = Unmarshal(text, Textproto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Marshal(decoded, Binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, binary) {
+		t.Errorf("binary -> textproto -> binary changed the contents:\n%s", text)
+	}
+}
+
+func TestDocumentURI(t *testing.T) {
+	testCases := []struct {
+		path string
+		uri  string
+		ok   bool
+	}{
+		{"/out/META-INF/semanticdb/src/A.scala.semanticdb", "src/A.scala", true},
+		{"META-INF/semanticdb/A.java.semanticdb.textproto", "A.java", true},
+		{"/out/META-INF/semanticdb/a/META-INF/semanticdb/A.scala.semanticdb.json", "A.scala", true},
+		{"/out/semanticdb/src/A.scala.semanticdb", "", false},
+		{"/out/META-INF/semanticdb/src/A.scala", "", false},
+	}
+
+	for _, testCase := range testCases {
+		if uri, ok := DocumentURI(filepath.FromSlash(testCase.path)); uri != testCase.uri || ok != testCase.ok {
+			t.Errorf("DocumentURI(%q) = %q, %v, want %q, %v", testCase.path, uri, ok, testCase.uri, testCase.ok)
+		}
+	}
+}
+
+func TestWalk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]Format{
+		"a.semanticdb":             Binary,
+		"b/b.semanticdb.textproto": Textproto,
+		"b/c.semanticdb.json":      JSON,
+	}
+	for name, format := range files {
+		contents, err := Marshal(testDocuments, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Other files are not read
+	if err := ioutil.WriteFile(filepath.Join(dir, "b", "README"), []byte("{ invalid"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	err = Walk([]string{dir}, func(path string, textDocuments *pb.TextDocuments) error {
+		if !proto.Equal(textDocuments, testDocuments) {
+			t.Errorf("%s: got %v, want %v", path, textDocuments, testDocuments)
+		}
+		name, err := filepath.Rel(dir, path)
+		names = append(names, filepath.ToSlash(name))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(names)
+
+	if want := []string{"a.semanticdb", "b/b.semanticdb.textproto", "b/c.semanticdb.json"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Walk() read %v, want %v", names, want)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "d.semanticdb.json"), []byte("{ invalid"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Walk([]string{dir}, func(string, *pb.TextDocuments) error { return nil }); err == nil {
+		t.Errorf("expected an error for an invalid file")
+	}
+}
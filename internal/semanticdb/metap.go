@@ -0,0 +1,536 @@
+package semanticdb
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+// PrintDocument writes a document like the metap tool of SemanticDB: a
+// summary, the symbol table and the occurrences, followed by the source text
+// with each occurrence annotated inline, e.g. `Foo/*<=example/Foo#*/` for a
+// definition and `foo/*=>example/Foo#foo().*/` for a reference. The text of
+// the document is used unless text is given.
+func PrintDocument(w io.Writer, document *pb.TextDocument, text string) error {
+	if text == "" {
+		text = document.GetText()
+	}
+
+	infos := map[string]*pb.SymbolInformation{}
+	for _, info := range document.GetSymbols() {
+		infos[info.GetSymbol()] = info
+	}
+	lookup := func(symbol string) *pb.SymbolInformation { return infos[symbol] }
+	dialect := DialectOf(document)
+
+	p := &printer{w: w}
+	p.printf("%s\n%s\n\n", document.GetUri(), strings.Repeat("-", len(document.GetUri())))
+
+	p.printf("Summary:\n")
+	p.printf("Schema => %s\n", schemaName(document.GetSchema()))
+	p.printf("Uri => %s\n", document.GetUri())
+	if text == "" {
+		p.printf("Text => empty\n")
+	} else {
+		p.printf("Text => non-empty\n")
+	}
+	p.printf("Language => %s\n", languageName(document.GetLanguage()))
+	p.printf("Symbols => %d entries\n", len(document.GetSymbols()))
+	p.printf("Occurrences => %d entries\n", len(document.GetOccurrences()))
+	if n := len(document.GetDiagnostics()); n > 0 {
+		p.printf("Diagnostics => %d entries\n", n)
+	}
+	if n := len(document.GetSynthetics()); n > 0 {
+		p.printf("Synthetics => %d entries\n", n)
+	}
+
+	if len(document.GetSymbols()) > 0 {
+		p.printf("\nSymbols:\n")
+		symbols := append([]*pb.SymbolInformation(nil), document.GetSymbols()...)
+		sort.SliceStable(symbols, func(i, j int) bool { return symbols[i].GetSymbol() < symbols[j].GetSymbol() })
+		for _, info := range symbols {
+			p.printf("%s => %s\n", info.GetSymbol(), SymbolDescription(info, dialect, lookup))
+		}
+	}
+
+	occurrences := append([]*pb.SymbolOccurrence(nil), document.GetOccurrences()...)
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return compareRanges(occurrences[i].GetRange(), occurrences[j].GetRange()) < 0
+	})
+
+	if len(occurrences) > 0 {
+		lines := strings.Split(text, "\n")
+
+		p.printf("\nOccurrences:\n")
+		for _, occurrence := range occurrences {
+			r := occurrence.GetRange()
+			p.printf("[%d:%d..%d:%d): ", r.GetStartLine(), r.GetStartCharacter(), r.GetEndLine(), r.GetEndCharacter())
+			if start, end, ok := rangeOffsets(lines, r); ok && start < end {
+				p.printf("%s ", text[start:end])
+			}
+			p.printf("%s %s\n", roleArrow(occurrence.GetRole()), occurrence.GetSymbol())
+		}
+	}
+
+	if text != "" {
+		p.printf("\nSource:\n%s", annotate(text, occurrences))
+		if !strings.HasSuffix(text, "\n") {
+			p.printf("\n")
+		}
+	}
+
+	return p.err
+}
+
+// SymbolDescription describes a symbol like metap, e.g. `case class Foo` or
+// `method bar(y: Int): Int`. Java symbols are described by their declaration.
+func SymbolDescription(info *pb.SymbolInformation, dialect Dialect, lookup func(symbol string) *pb.SymbolInformation) string {
+	if dialect == Java && info.GetSignature() != nil {
+		if signature := JavaSignature(info, lookup); signature != "" {
+			// Annotations are printed on lines of their own
+			return strings.Replace(signature, "\n", " ", -1)
+		}
+	}
+
+	var parts []string
+	if access := accessName(info.GetAccess()); access != "" {
+		parts = append(parts, access)
+	}
+	for _, property := range scalaProperties {
+		if hasProperty(info, property) {
+			parts = append(parts, strings.ToLower(property.String()))
+		}
+	}
+	parts = append(parts, kindName(info.GetKind()))
+
+	p := &scalaPrinter{lookup: lookup}
+	return strings.Join(parts, " ") + " " + info.GetDisplayName() + p.signature(info.GetSignature())
+}
+
+// scalaProperties are the properties listed in symbol descriptions in the
+// order used by metap.
+var scalaProperties = []pb.SymbolInformation_Property{
+	pb.SymbolInformation_ABSTRACT,
+	pb.SymbolInformation_FINAL,
+	pb.SymbolInformation_SEALED,
+	pb.SymbolInformation_IMPLICIT,
+	pb.SymbolInformation_LAZY,
+	pb.SymbolInformation_CASE,
+	pb.SymbolInformation_COVARIANT,
+	pb.SymbolInformation_CONTRAVARIANT,
+	pb.SymbolInformation_VAL,
+	pb.SymbolInformation_VAR,
+	pb.SymbolInformation_STATIC,
+	pb.SymbolInformation_PRIMARY,
+	pb.SymbolInformation_ENUM,
+	pb.SymbolInformation_DEFAULT,
+	pb.SymbolInformation_GIVEN,
+	pb.SymbolInformation_INLINE,
+	pb.SymbolInformation_OPEN,
+	pb.SymbolInformation_TRANSPARENT,
+	pb.SymbolInformation_INFIX,
+	pb.SymbolInformation_OPAQUE,
+}
+
+var kindNames = map[pb.SymbolInformation_Kind]string{
+	pb.SymbolInformation_LOCAL:          "local",
+	pb.SymbolInformation_FIELD:          "field",
+	pb.SymbolInformation_METHOD:         "method",
+	pb.SymbolInformation_CONSTRUCTOR:    "ctor",
+	pb.SymbolInformation_MACRO:          "macro",
+	pb.SymbolInformation_TYPE:           "type",
+	pb.SymbolInformation_PARAMETER:      "param",
+	pb.SymbolInformation_SELF_PARAMETER: "selfparam",
+	pb.SymbolInformation_TYPE_PARAMETER: "typeparam",
+	pb.SymbolInformation_OBJECT:         "object",
+	pb.SymbolInformation_PACKAGE:        "package",
+	pb.SymbolInformation_PACKAGE_OBJECT: "package object",
+	pb.SymbolInformation_CLASS:          "class",
+	pb.SymbolInformation_TRAIT:          "trait",
+	pb.SymbolInformation_INTERFACE:      "interface",
+}
+
+func kindName(kind pb.SymbolInformation_Kind) string {
+	if name, ok := kindNames[kind]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+func accessName(access *pb.Access) string {
+	switch {
+	case access.GetPrivateAccess() != nil:
+		return "private"
+	case access.GetPrivateThisAccess() != nil:
+		return "private[this]"
+	case access.GetPrivateWithinAccess() != nil:
+		return "private[" + symbolName(access.GetPrivateWithinAccess().GetSymbol()) + "]"
+	case access.GetProtectedAccess() != nil:
+		return "protected"
+	case access.GetProtectedThisAccess() != nil:
+		return "protected[this]"
+	case access.GetProtectedWithinAccess() != nil:
+		return "protected[" + symbolName(access.GetProtectedWithinAccess().GetSymbol()) + "]"
+	}
+	return ""
+}
+
+func schemaName(schema pb.Schema) string {
+	switch schema {
+	case pb.Schema_SEMANTICDB3:
+		return "SemanticDB v3"
+	case pb.Schema_SEMANTICDB4:
+		return "SemanticDB v4"
+	}
+	return "SemanticDB legacy"
+}
+
+func languageName(language pb.Language) string {
+	switch language {
+	case pb.Language_SCALA:
+		return "Scala"
+	case pb.Language_JAVA:
+		return "Java"
+	}
+	return "unknown"
+}
+
+func roleArrow(role pb.SymbolOccurrence_Role) string {
+	if role == pb.SymbolOccurrence_DEFINITION {
+		return "<="
+	}
+	return "=>"
+}
+
+// maxSignatureDepth limits the nesting of signatures printed, as scopes may
+// refer back to the symbols they belong to.
+const maxSignatureDepth = 8
+
+// scalaPrinter renders signatures in Scala syntax.
+type scalaPrinter struct {
+	lookup func(symbol string) *pb.SymbolInformation
+	depth  int
+}
+
+func (p *scalaPrinter) signature(signature *pb.Signature) string {
+	if p.depth >= maxSignatureDepth {
+		return ""
+	}
+	p.depth++
+	defer func() { p.depth-- }()
+
+	switch s := signature.GetSealedValue().(type) {
+	case *pb.Signature_ClassSignature:
+		c := s.ClassSignature
+		var b strings.Builder
+		b.WriteString(p.typeParameters(c.GetTypeParameters()))
+		if len(c.GetParents()) > 0 {
+			b.WriteString(" extends " + p.types(c.GetParents(), " with "))
+		}
+		if n := len(c.GetDeclarations().GetSymlinks()) + len(c.GetDeclarations().GetHardlinks()); n > 0 {
+			fmt.Fprintf(&b, " { +%d decls }", n)
+		}
+		return b.String()
+
+	case *pb.Signature_MethodSignature:
+		m := s.MethodSignature
+		var b strings.Builder
+		b.WriteString(p.typeParameters(m.GetTypeParameters()))
+		for _, parameters := range m.GetParameterLists() {
+			var params []string
+			for _, info := range p.scope(parameters) {
+				params = append(params, info.GetDisplayName()+p.valueType(info))
+			}
+			b.WriteString("(" + strings.Join(params, ", ") + ")")
+		}
+		if returnType := m.GetReturnType(); returnType != nil {
+			b.WriteString(": " + p.typ(returnType))
+		}
+		return b.String()
+
+	case *pb.Signature_TypeSignature:
+		t := s.TypeSignature
+		var b strings.Builder
+		b.WriteString(p.typeParameters(t.GetTypeParameters()))
+		b.WriteString(p.bounds(t))
+		return b.String()
+
+	case *pb.Signature_ValueSignature:
+		return ": " + p.typ(s.ValueSignature.GetTpe())
+	}
+
+	return ""
+}
+
+// valueType returns the type annotation of a parameter.
+func (p *scalaPrinter) valueType(info *pb.SymbolInformation) string {
+	if tpe := info.GetSignature().GetValueSignature().GetTpe(); tpe != nil {
+		return ": " + p.typ(tpe)
+	}
+	return ""
+}
+
+func (p *scalaPrinter) typeParameters(scope *pb.Scope) string {
+	var params []string
+	for _, info := range p.scope(scope) {
+		params = append(params, info.GetDisplayName()+p.signature(info.GetSignature()))
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+func (p *scalaPrinter) bounds(t *pb.TypeSignature) string {
+	lower, upper := t.GetLowerBound(), t.GetUpperBound()
+	if lower != nil && upper != nil && p.typ(lower) == p.typ(upper) {
+		return " = " + p.typ(lower)
+	}
+
+	var b strings.Builder
+	if lower != nil && typeRefSymbol(lower) != "scala/Nothing#" {
+		b.WriteString(" >: " + p.typ(lower))
+	}
+	if upper != nil && typeRefSymbol(upper) != "scala/Any#" {
+		b.WriteString(" <: " + p.typ(upper))
+	}
+	return b.String()
+}
+
+// scope returns the symbols of a scope, looking up symlinks in the symbol
+// table. Symbols missing from the table are described by their name only.
+func (p *scalaPrinter) scope(scope *pb.Scope) []*pb.SymbolInformation {
+	infos := append([]*pb.SymbolInformation(nil), scope.GetHardlinks()...)
+	for _, symbol := range scope.GetSymlinks() {
+		info := p.lookup(symbol)
+		if info == nil {
+			info = &pb.SymbolInformation{Symbol: symbol, DisplayName: symbolName(symbol)}
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+func (p *scalaPrinter) types(types []*pb.Type, separator string) string {
+	var s []string
+	for _, tpe := range types {
+		s = append(s, p.typ(tpe))
+	}
+	return strings.Join(s, separator)
+}
+
+func (p *scalaPrinter) typ(t *pb.Type) string {
+	switch t := t.GetSealedValue().(type) {
+	case *pb.Type_TypeRef:
+		s := symbolName(t.TypeRef.GetSymbol())
+		if arguments := t.TypeRef.GetTypeArguments(); len(arguments) > 0 {
+			s += "[" + p.types(arguments, ", ") + "]"
+		}
+		return s
+
+	case *pb.Type_SingleType:
+		return symbolName(t.SingleType.GetSymbol()) + ".type"
+
+	case *pb.Type_ThisType:
+		if symbol := t.ThisType.GetSymbol(); symbol != "" {
+			return symbolName(symbol) + ".this.type"
+		}
+		return "this.type"
+
+	case *pb.Type_SuperType:
+		return "super[" + symbolName(t.SuperType.GetSymbol()) + "]"
+
+	case *pb.Type_ConstantType:
+		return constant(t.ConstantType.GetConstant())
+
+	case *pb.Type_IntersectionType:
+		return p.types(t.IntersectionType.GetTypes(), " & ")
+
+	case *pb.Type_UnionType:
+		return p.types(t.UnionType.GetTypes(), " | ")
+
+	case *pb.Type_WithType:
+		return p.types(t.WithType.GetTypes(), " with ")
+
+	case *pb.Type_StructuralType:
+		var decls []string
+		for _, info := range p.scope(t.StructuralType.GetDeclarations()) {
+			decls = append(decls, kindName(info.GetKind())+" "+info.GetDisplayName())
+		}
+		return p.typ(t.StructuralType.GetTpe()) + " { " + strings.Join(decls, "; ") + " }"
+
+	case *pb.Type_AnnotatedType:
+		s := p.typ(t.AnnotatedType.GetTpe())
+		for _, annotation := range t.AnnotatedType.GetAnnotations() {
+			s += " @" + p.typ(annotation.GetTpe())
+		}
+		return s
+
+	case *pb.Type_ExistentialType:
+		var decls []string
+		for _, info := range p.scope(t.ExistentialType.GetDeclarations()) {
+			decls = append(decls, "type "+info.GetDisplayName()+p.signature(info.GetSignature()))
+		}
+		return p.typ(t.ExistentialType.GetTpe()) + " forSome { " + strings.Join(decls, "; ") + " }"
+
+	case *pb.Type_UniversalType:
+		return p.typeParameters(t.UniversalType.GetTypeParameters()) + " => " + p.typ(t.UniversalType.GetTpe())
+
+	case *pb.Type_ByNameType:
+		return "=> " + p.typ(t.ByNameType.GetTpe())
+
+	case *pb.Type_RepeatedType:
+		return p.typ(t.RepeatedType.GetTpe()) + "*"
+
+	case *pb.Type_MatchType:
+		var cases []string
+		for _, c := range t.MatchType.GetCases() {
+			cases = append(cases, "case "+p.typ(c.GetKey())+" => "+p.typ(c.GetBody()))
+		}
+		return p.typ(t.MatchType.GetScrutinee()) + " match { " + strings.Join(cases, "; ") + " }"
+
+	case *pb.Type_LambdaType:
+		return p.typeParameters(t.LambdaType.GetParameters()) + " =>> " + p.typ(t.LambdaType.GetReturnType())
+	}
+
+	return "<?>"
+}
+
+func constant(c *pb.Constant) string {
+	switch c := c.GetSealedValue().(type) {
+	case *pb.Constant_UnitConstant:
+		return "()"
+	case *pb.Constant_BooleanConstant:
+		return strconv.FormatBool(c.BooleanConstant.GetValue())
+	case *pb.Constant_ByteConstant:
+		return strconv.Itoa(int(c.ByteConstant.GetValue()))
+	case *pb.Constant_ShortConstant:
+		return strconv.Itoa(int(c.ShortConstant.GetValue()))
+	case *pb.Constant_CharConstant:
+		return strconv.QuoteRune(rune(c.CharConstant.GetValue()))
+	case *pb.Constant_IntConstant:
+		return strconv.Itoa(int(c.IntConstant.GetValue()))
+	case *pb.Constant_LongConstant:
+		return strconv.FormatInt(c.LongConstant.GetValue(), 10) + "L"
+	case *pb.Constant_FloatConstant:
+		return strconv.FormatFloat(float64(c.FloatConstant.GetValue()), 'g', -1, 32) + "f"
+	case *pb.Constant_DoubleConstant:
+		return strconv.FormatFloat(c.DoubleConstant.GetValue(), 'g', -1, 64)
+	case *pb.Constant_StringConstant:
+		return strconv.Quote(c.StringConstant.GetValue())
+	case *pb.Constant_NullConstant:
+		return "null"
+	}
+	return "<?>"
+}
+
+// annotate inserts a comment naming the symbol after each occurrence in the
+// text. Occurrences outside of the text are skipped.
+func annotate(text string, occurrences []*pb.SymbolOccurrence) string {
+	lines := strings.Split(text, "\n")
+
+	type annotation struct {
+		offset  int
+		comment string
+	}
+	var annotations []annotation
+	for _, occurrence := range occurrences {
+		if _, end, ok := rangeOffsets(lines, occurrence.GetRange()); ok {
+			comment := "/*" + roleArrow(occurrence.GetRole()) + occurrence.GetSymbol() + "*/"
+			annotations = append(annotations, annotation{offset: end, comment: comment})
+		}
+	}
+	sort.SliceStable(annotations, func(i, j int) bool { return annotations[i].offset < annotations[j].offset })
+
+	var b strings.Builder
+	last := 0
+	for _, a := range annotations {
+		b.WriteString(text[last:a.offset])
+		b.WriteString(a.comment)
+		last = a.offset
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// rangeOffsets returns the byte offsets of a range in the text split into
+// lines. Characters are counted in UTF-16 code units as specified by
+// SemanticDB. It returns false if the range is not within the text.
+func rangeOffsets(lines []string, r *pb.Range) (int, int, bool) {
+	if r == nil {
+		return 0, 0, false
+	}
+
+	start, ok := lineOffset(lines, int(r.GetStartLine()), int(r.GetStartCharacter()))
+	if !ok {
+		return 0, 0, false
+	}
+	end, ok := lineOffset(lines, int(r.GetEndLine()), int(r.GetEndCharacter()))
+	if !ok || end < start {
+		return 0, 0, false
+	}
+
+	return start, end, true
+}
+
+func lineOffset(lines []string, line, character int) (int, bool) {
+	if line < 0 || line >= len(lines) || character < 0 {
+		return 0, false
+	}
+
+	offset := 0
+	for _, l := range lines[:line] {
+		offset += len(l) + 1
+	}
+
+	units := 0
+	for i, r := range lines[line] {
+		if units >= character {
+			return offset + i, units == character
+		}
+		units += UTF16Len(r)
+	}
+	if units == character {
+		return offset + len(lines[line]), true
+	}
+	return 0, false
+}
+
+// UTF16Len returns the number of UTF-16 code units encoding a rune, the unit
+// of the character offsets of SemanticDB ranges.
+func UTF16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+func compareRanges(a, b *pb.Range) int {
+	for _, d := range []int32{
+		a.GetStartLine() - b.GetStartLine(),
+		a.GetStartCharacter() - b.GetStartCharacter(),
+		a.GetEndLine() - b.GetEndLine(),
+		a.GetEndCharacter() - b.GetEndCharacter(),
+	} {
+		if d != 0 {
+			return int(d)
+		}
+	}
+	return 0
+}
+
+// printer remembers the first error writing to w.
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
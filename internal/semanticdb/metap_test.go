@@ -0,0 +1,59 @@
+package semanticdb
+
+import (
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+func TestAnnotate(t *testing.T) {
+	// The emoji is two UTF-16 code units long
+	text := "val s = \"😀\"; foo(s)\n"
+	occurrences := []*pb.SymbolOccurrence{
+		{Range: &pb.Range{StartLine: 0, StartCharacter: 4, EndLine: 0, EndCharacter: 5}, Symbol: "local0", Role: pb.SymbolOccurrence_DEFINITION},
+		{Range: &pb.Range{StartLine: 0, StartCharacter: 14, EndLine: 0, EndCharacter: 17}, Symbol: "pkg/Foo#foo().", Role: pb.SymbolOccurrence_REFERENCE},
+		{Range: &pb.Range{StartLine: 0, StartCharacter: 18, EndLine: 0, EndCharacter: 19}, Symbol: "local0", Role: pb.SymbolOccurrence_REFERENCE},
+		{Range: &pb.Range{StartLine: 3, StartCharacter: 0, EndLine: 3, EndCharacter: 1}, Symbol: "outside", Role: pb.SymbolOccurrence_REFERENCE},
+	}
+
+	want := "val s/*<=local0*/ = \"😀\"; foo/*=>pkg/Foo#foo().*/(s/*=>local0*/)\n"
+	if got := annotate(text, occurrences); got != want {
+		t.Errorf("annotate:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestSymbolDescription(t *testing.T) {
+	infos := map[string]*pb.SymbolInformation{
+		"pkg/Foo#bar().[T]": {Symbol: "pkg/Foo#bar().[T]", Kind: pb.SymbolInformation_TYPE_PARAMETER, DisplayName: "T"},
+		"pkg/Foo#bar().(xs)": {Symbol: "pkg/Foo#bar().(xs)", Kind: pb.SymbolInformation_PARAMETER, DisplayName: "xs", Signature: valueSignature(
+			&pb.Type{SealedValue: &pb.Type_RepeatedType{RepeatedType: &pb.RepeatedType{Tpe: typeRef("pkg/Foo#bar().[T]")}}},
+		)},
+	}
+	lookup := func(symbol string) *pb.SymbolInformation { return infos[symbol] }
+
+	info := &pb.SymbolInformation{
+		Symbol:      "pkg/Foo#bar().",
+		Kind:        pb.SymbolInformation_METHOD,
+		Properties:  int32(pb.SymbolInformation_FINAL),
+		DisplayName: "bar",
+		Access:      &pb.Access{SealedValue: &pb.Access_PrivateThisAccess{PrivateThisAccess: &pb.PrivateThisAccess{}}},
+		Signature: &pb.Signature{SealedValue: &pb.Signature_MethodSignature{MethodSignature: &pb.MethodSignature{
+			TypeParameters: &pb.Scope{Symlinks: []string{"pkg/Foo#bar().[T]"}},
+			ParameterLists: []*pb.Scope{{Symlinks: []string{"pkg/Foo#bar().(xs)"}}},
+			ReturnType:     typeRef("scala/collection/immutable/List#", typeRef("pkg/Foo#bar().[T]")),
+		}}},
+	}
+
+	want := "private[this] final method bar[T](xs: T*): List[T]"
+	if got := SymbolDescription(info, Scala2, lookup); got != want {
+		t.Errorf("SymbolDescription:\n got %q\nwant %q", got, want)
+	}
+}
+
+func typeRef(symbol string, arguments ...*pb.Type) *pb.Type {
+	return &pb.Type{SealedValue: &pb.Type_TypeRef{TypeRef: &pb.TypeRef{Symbol: symbol, TypeArguments: arguments}}}
+}
+
+func valueSignature(tpe *pb.Type) *pb.Signature {
+	return &pb.Signature{SealedValue: &pb.Signature_ValueSignature{ValueSignature: &pb.ValueSignature{Tpe: tpe}}}
+}
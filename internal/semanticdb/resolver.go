@@ -0,0 +1,138 @@
+package semanticdb
+
+import pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+
+// Resolver maps referenced symbols to the symbols that have a definition
+// occurrence. It knows the definitions, export forwarders, top-level
+// definitions, constructors and record components of all documents added to
+// it.
+type Resolver struct {
+	defined      map[string]bool            // Keys: global symbol
+	locals       map[string]map[string]bool // Keys: document uri, local symbol
+	aliases      map[string]string          // Keys: export forwarder
+	topLevel     map[string]string          // Keys: TopLevelKey of a defined symbol
+	constructors map[string]string          // Keys: constructor; values: class
+	accessors    map[string]string          // Keys: record accessor; values: record component
+}
+
+// NewResolver creates a new, empty Resolver.
+func NewResolver() *Resolver {
+	return &Resolver{
+		defined:      map[string]bool{},
+		locals:       map[string]map[string]bool{},
+		aliases:      map[string]string{},
+		topLevel:     map[string]string{},
+		constructors: map[string]string{},
+		accessors:    map[string]string{},
+	}
+}
+
+// AddDocument registers the definitions, export forwarders, top-level
+// definitions, constructors and record components of the document. Scala
+// instantiations navigate to the class only for primary constructors, Java
+// instantiations for all constructors.
+func (r *Resolver) AddDocument(document *pb.TextDocument) {
+	dialect := DialectOf(document)
+
+	records := map[string]bool{}
+	for _, info := range document.GetSymbols() {
+		if s := info.GetSignature().GetClassSignature(); s != nil && dialect == Java && hasParent(s.GetParents(), javaRecord) {
+			records[info.GetSymbol()] = true
+		}
+	}
+
+	for _, info := range document.GetSymbols() {
+		owner, descriptor, ok := ParseSymbol(info.GetSymbol())
+		if !ok {
+			continue
+		}
+
+		switch info.GetKind() {
+		case pb.SymbolInformation_CONSTRUCTOR:
+			if dialect == Java || hasProperty(info, pb.SymbolInformation_PRIMARY) {
+				r.constructors[info.GetSymbol()] = owner
+			}
+
+		case pb.SymbolInformation_FIELD:
+			// Records have an implicit accessor for each of their components
+			if records[owner] && !hasProperty(info, pb.SymbolInformation_STATIC) {
+				r.accessors[owner+Descriptor{Name: descriptor.Name, Suffix: MethodSuffix, Disambiguator: "()"}.String()] = info.GetSymbol()
+			}
+		}
+	}
+
+	if dialect == Scala3 {
+		for forwarder, target := range ExportAliases(document) {
+			r.aliases[forwarder] = target
+		}
+	}
+
+	for _, occurrence := range document.GetOccurrences() {
+		if occurrence.GetRole() != pb.SymbolOccurrence_DEFINITION {
+			continue
+		}
+
+		symbol := occurrence.GetSymbol()
+		if IsLocal(symbol) {
+			if r.locals[document.GetUri()] == nil {
+				r.locals[document.GetUri()] = map[string]bool{}
+			}
+			r.locals[document.GetUri()][symbol] = true
+			continue
+		}
+		r.defined[symbol] = true
+
+		if key := TopLevelKey(occurrence.GetSymbol()); key != "" {
+			if _, ok := r.topLevel[key]; !ok {
+				r.topLevel[key] = occurrence.GetSymbol()
+			}
+		}
+	}
+}
+
+// Definition returns the defined symbol a reference to the given symbol from
+// the document with the given uri and dialect refers to. Local symbols only
+// resolve to definitions within the same document.
+func (r *Resolver) Definition(uri string, dialect Dialect, symbol string) (key string, isLocal bool, ok bool) {
+	if r.locals[uri][symbol] {
+		return symbol, true, true
+	}
+
+	key, ok = r.Resolve(symbol, dialect, func(k string) bool {
+		return r.defined[k]
+	})
+	return key, false, ok
+}
+
+// Resolve returns the defined symbol a reference to the given global symbol
+// refers to. The defined function reports whether a symbol has a definition.
+func (r *Resolver) Resolve(symbol string, dialect Dialect, defined func(symbol string) bool) (string, bool) {
+	if target, ok := r.aliases[symbol]; ok && defined(target) {
+		symbol = target
+	}
+
+	// Instantiations navigate to the class rather than its primary constructor
+	if class, ok := r.constructors[symbol]; ok && defined(class) {
+		return class, true
+	}
+
+	for _, candidate := range Candidates(symbol, dialect) {
+		if defined(candidate) {
+			return candidate, true
+		}
+	}
+
+	// Implicit record accessors, unless the record declares the accessor
+	if field, ok := r.accessors[symbol]; ok && defined(field) {
+		return field, true
+	}
+
+	// Top-level definitions referenced through a different wrapper object
+	if key := TopLevelKey(symbol); key != "" {
+		if candidate, ok := r.topLevel[key]; ok && defined(candidate) {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
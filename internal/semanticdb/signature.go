@@ -0,0 +1,351 @@
+package semanticdb
+
+import (
+	"strings"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+// HoverText returns the text describing a symbol in hovers. Java symbols are
+// rendered as Java declarations; other symbols use their display name.
+func HoverText(info *pb.SymbolInformation, dialect Dialect, lookup func(symbol string) *pb.SymbolInformation) string {
+	if dialect == Java {
+		if signature := JavaSignature(info, lookup); signature != "" {
+			return signature
+		}
+	}
+
+	return info.GetDisplayName()
+}
+
+// javaPrimitives maps the symbols semanticdb-javac uses for primitive types
+// to their Java keywords.
+var javaPrimitives = map[string]string{
+	"scala/Unit#":    "void",
+	"scala/Boolean#": "boolean",
+	"scala/Byte#":    "byte",
+	"scala/Short#":   "short",
+	"scala/Char#":    "char",
+	"scala/Int#":     "int",
+	"scala/Long#":    "long",
+	"scala/Float#":   "float",
+	"scala/Double#":  "double",
+}
+
+const (
+	javaObject = "java/lang/Object#"
+	javaEnum   = "java/lang/Enum#"
+	javaRecord = "java/lang/Record#"
+)
+
+// JavaSignature renders the declaration of a Java symbol, e.g.
+// "public static <T> List<T> of(T... elements) throws IOException". The
+// lookup function resolves the parameters and type parameters referenced by
+// the signature and may return nil. It returns the empty string for symbols
+// without a signature.
+func JavaSignature(info *pb.SymbolInformation, lookup func(symbol string) *pb.SymbolInformation) string {
+	p := &javaPrinter{lookup: lookup}
+
+	switch s := info.GetSignature().GetSealedValue().(type) {
+	case *pb.Signature_ClassSignature:
+		p.class(info, s.ClassSignature)
+	case *pb.Signature_MethodSignature:
+		p.method(info, s.MethodSignature)
+	case *pb.Signature_ValueSignature:
+		p.modifiers(info)
+		p.printf(p.typ(s.ValueSignature.GetTpe()), " ", info.GetDisplayName())
+	default:
+		return ""
+	}
+
+	return strings.TrimSpace(p.b.String())
+}
+
+type javaPrinter struct {
+	b      strings.Builder
+	lookup func(symbol string) *pb.SymbolInformation
+
+	// Wildcards of the enclosing existential types
+	wildcards map[string]*pb.SymbolInformation
+
+	// Omits the final modifier of records
+	implicitFinal bool
+}
+
+func (p *javaPrinter) printf(parts ...string) {
+	for _, part := range parts {
+		p.b.WriteString(part)
+	}
+}
+
+func (p *javaPrinter) class(info *pb.SymbolInformation, s *pb.ClassSignature) {
+	parents := s.GetParents()
+	keyword := "class"
+	switch {
+	case info.GetKind() == pb.SymbolInformation_INTERFACE:
+		keyword = "interface"
+	case hasProperty(info, pb.SymbolInformation_ENUM):
+		keyword, parents = "enum", withoutParent(parents, javaEnum)
+	case hasParent(parents, javaRecord):
+		keyword, parents = "record", withoutParent(parents, javaRecord)
+		p.implicitFinal = true
+	}
+
+	p.modifiers(info)
+	p.printf(keyword, " ", info.GetDisplayName(), p.typeParameters(s.GetTypeParameters()))
+
+	var extends, implements []string
+	for i, parent := range parents {
+		if typeRefSymbol(parent) == javaObject {
+			continue
+		}
+
+		// semanticdb-javac lists the superclass of a class first
+		if keyword == "interface" || keyword == "class" && i == 0 {
+			extends = append(extends, p.typ(parent))
+		} else {
+			implements = append(implements, p.typ(parent))
+		}
+	}
+
+	if len(extends) > 0 {
+		p.printf(" extends ", strings.Join(extends, ", "))
+	}
+	if len(implements) > 0 {
+		p.printf(" implements ", strings.Join(implements, ", "))
+	}
+}
+
+func (p *javaPrinter) method(info *pb.SymbolInformation, s *pb.MethodSignature) {
+	p.modifiers(info)
+
+	if typeParameters := p.typeParameters(s.GetTypeParameters()); typeParameters != "" {
+		p.printf(typeParameters, " ")
+	}
+
+	name := info.GetDisplayName()
+	if info.GetKind() == pb.SymbolInformation_CONSTRUCTOR {
+		if owner, _, ok := ParseSymbol(info.GetSymbol()); ok {
+			if _, descriptor, ok := ParseSymbol(owner); ok {
+				name = descriptor.Name
+			}
+		}
+	} else {
+		p.printf(p.typ(s.GetReturnType()), " ")
+	}
+
+	var parameters []string
+	for _, scope := range s.GetParameterLists() {
+		for _, parameter := range p.scope(scope) {
+			// Parameters that cannot be looked up have no type
+			parameters = append(parameters, strings.TrimSpace(p.typ(parameter.GetSignature().GetValueSignature().GetTpe())+" "+parameter.GetDisplayName()))
+		}
+	}
+	p.printf(name, "(", strings.Join(parameters, ", "), ")")
+
+	if len(s.GetThrows()) > 0 {
+		var throws []string
+		for _, t := range s.GetThrows() {
+			throws = append(throws, p.typ(t))
+		}
+		p.printf(" throws ", strings.Join(throws, ", "))
+	}
+}
+
+// modifiers prints the annotations, access and modifiers of a declaration.
+func (p *javaPrinter) modifiers(info *pb.SymbolInformation) {
+	for _, annotation := range info.GetAnnotations() {
+		p.printf("@", p.typ(annotation.GetTpe()), "\n")
+	}
+
+	switch info.GetAccess().GetSealedValue().(type) {
+	case *pb.Access_PublicAccess:
+		p.printf("public ")
+	case *pb.Access_ProtectedAccess:
+		p.printf("protected ")
+	case *pb.Access_PrivateAccess:
+		p.printf("private ")
+	}
+
+	// Interfaces and their methods are implicitly abstract
+	isInterface := info.GetKind() == pb.SymbolInformation_INTERFACE || p.ownerKind(info) == pb.SymbolInformation_INTERFACE
+	if hasProperty(info, pb.SymbolInformation_ABSTRACT) && !isInterface {
+		p.printf("abstract ")
+	}
+	if hasProperty(info, pb.SymbolInformation_DEFAULT) {
+		p.printf("default ")
+	}
+	if hasProperty(info, pb.SymbolInformation_STATIC) {
+		p.printf("static ")
+	}
+	if hasProperty(info, pb.SymbolInformation_FINAL) && !hasProperty(info, pb.SymbolInformation_ENUM) && !p.implicitFinal {
+		p.printf("final ")
+	}
+}
+
+// ownerKind returns the kind of the owner of a symbol if it can be looked up.
+func (p *javaPrinter) ownerKind(info *pb.SymbolInformation) pb.SymbolInformation_Kind {
+	owner, _, ok := ParseSymbol(info.GetSymbol())
+	if !ok || p.lookup == nil {
+		return pb.SymbolInformation_UNKNOWN_KIND
+	}
+	return p.lookup(owner).GetKind()
+}
+
+func (p *javaPrinter) typeParameters(scope *pb.Scope) string {
+	var parameters []string
+	for _, parameter := range p.scope(scope) {
+		s := parameter.GetDisplayName()
+		if bound := p.upperBound(parameter); bound != "" {
+			s += " extends " + bound
+		}
+		parameters = append(parameters, s)
+	}
+
+	if len(parameters) == 0 {
+		return ""
+	}
+	return "<" + strings.Join(parameters, ", ") + ">"
+}
+
+func (p *javaPrinter) upperBound(info *pb.SymbolInformation) string {
+	bound := info.GetSignature().GetTypeSignature().GetUpperBound()
+	if bound == nil || typeRefSymbol(bound) == javaObject {
+		return ""
+	}
+
+	if intersection := bound.GetIntersectionType(); intersection != nil {
+		var types []string
+		for _, t := range intersection.GetTypes() {
+			types = append(types, p.typ(t))
+		}
+		return strings.Join(types, " & ")
+	}
+
+	return p.typ(bound)
+}
+
+// scope returns the symbols of a scope, resolving symlinks with lookup.
+func (p *javaPrinter) scope(scope *pb.Scope) []*pb.SymbolInformation {
+	infos := append([]*pb.SymbolInformation(nil), scope.GetHardlinks()...)
+	for _, symbol := range scope.GetSymlinks() {
+		var info *pb.SymbolInformation
+		if p.lookup != nil {
+			info = p.lookup(symbol)
+		}
+		if info == nil {
+			info = &pb.SymbolInformation{Symbol: symbol, DisplayName: symbolName(symbol)}
+		}
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+func (p *javaPrinter) typ(t *pb.Type) string {
+	switch t := t.GetSealedValue().(type) {
+	case *pb.Type_TypeRef:
+		return p.typeRef(t.TypeRef)
+
+	case *pb.Type_ExistentialType:
+		wildcards := p.wildcards
+		p.wildcards = map[string]*pb.SymbolInformation{}
+		for symbol, info := range wildcards {
+			p.wildcards[symbol] = info
+		}
+		for _, info := range p.scope(t.ExistentialType.GetDeclarations()) {
+			p.wildcards[info.GetSymbol()] = info
+		}
+		defer func() { p.wildcards = wildcards }()
+		return p.typ(t.ExistentialType.GetTpe())
+
+	case *pb.Type_UniversalType:
+		return p.typ(t.UniversalType.GetTpe())
+
+	case *pb.Type_AnnotatedType:
+		var annotations []string
+		for _, annotation := range t.AnnotatedType.GetAnnotations() {
+			annotations = append(annotations, "@"+p.typ(annotation.GetTpe())+" ")
+		}
+		return strings.Join(annotations, "") + p.typ(t.AnnotatedType.GetTpe())
+
+	case *pb.Type_RepeatedType:
+		return p.typ(t.RepeatedType.GetTpe()) + "..."
+
+	case *pb.Type_IntersectionType:
+		var types []string
+		for _, tpe := range t.IntersectionType.GetTypes() {
+			types = append(types, p.typ(tpe))
+		}
+		return strings.Join(types, " & ")
+	}
+
+	return ""
+}
+
+func (p *javaPrinter) typeRef(t *pb.TypeRef) string {
+	symbol := t.GetSymbol()
+	if primitive, ok := javaPrimitives[symbol]; ok {
+		return primitive
+	}
+
+	if symbol == "scala/Array#" && len(t.GetTypeArguments()) == 1 {
+		return p.typ(t.GetTypeArguments()[0]) + "[]"
+	}
+
+	if wildcard, ok := p.wildcards[symbol]; ok {
+		if bound := p.upperBound(wildcard); bound != "" {
+			return "? extends " + bound
+		}
+		if lower := wildcard.GetSignature().GetTypeSignature().GetLowerBound(); lower != nil && typeRefSymbol(lower) != "scala/Nothing#" {
+			return "? super " + p.typ(lower)
+		}
+		return "?"
+	}
+
+	s := symbolName(symbol)
+	if len(t.GetTypeArguments()) > 0 {
+		var arguments []string
+		for _, argument := range t.GetTypeArguments() {
+			arguments = append(arguments, p.typ(argument))
+		}
+		s += "<" + strings.Join(arguments, ", ") + ">"
+	}
+
+	return s
+}
+
+// symbolName returns the name of the last descriptor of a symbol.
+func symbolName(symbol string) string {
+	if _, descriptor, ok := ParseSymbol(symbol); ok {
+		return descriptor.Name
+	}
+	return symbol
+}
+
+func typeRefSymbol(t *pb.Type) string {
+	return t.GetTypeRef().GetSymbol()
+}
+
+func hasParent(parents []*pb.Type, symbol string) bool {
+	for _, parent := range parents {
+		if typeRefSymbol(parent) == symbol {
+			return true
+		}
+	}
+	return false
+}
+
+func withoutParent(parents []*pb.Type, symbol string) []*pb.Type {
+	var filtered []*pb.Type
+	for _, parent := range parents {
+		if typeRefSymbol(parent) != symbol {
+			filtered = append(filtered, parent)
+		}
+	}
+	return filtered
+}
+
+func hasProperty(info *pb.SymbolInformation, property pb.SymbolInformation_Property) bool {
+	return info.GetProperties()&int32(property) != 0
+}
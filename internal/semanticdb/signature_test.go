@@ -0,0 +1,128 @@
+package semanticdb
+
+import (
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// javaSymbols are symbol informations in the format of semanticdb-javac. The
+// type parameters and parameters of methods are referenced by symlinks.
+var javaSymbols = []string{
+	`symbol: "j/Util#of().[T]" kind: TYPE_PARAMETER display_name: "T" signature { type_signature { upper_bound { type_ref { symbol: "java/lang/Number#" } } } }`,
+	`symbol: "j/Util#of().(xs)" kind: PARAMETER display_name: "xs" signature { value_signature { tpe { repeated_type { tpe { type_ref { symbol: "j/Util#of().[T]" } } } } } }`,
+	`symbol: "j/Box#<init>().(size)" kind: PARAMETER display_name: "size" signature { value_signature { tpe { type_ref { symbol: "scala/Int#" } } } }`,
+	`symbol: "j/Shape#" kind: INTERFACE properties: 0x4 display_name: "Shape" signature { class_signature { parents { type_ref { symbol: "java/lang/Object#" } } } }`,
+	`symbol: "j/Box#" kind: CLASS properties: 0x4 display_name: "Box" signature { class_signature { parents { type_ref { symbol: "java/lang/Object#" } } } }`,
+}
+
+func parseSymbolInformation(t *testing.T, text string) *pb.SymbolInformation {
+	info := &pb.SymbolInformation{}
+	if err := prototext.Unmarshal([]byte(text), info); err != nil {
+		t.Fatalf("unmarshal %q: %v", text, err)
+	}
+	return info
+}
+
+func TestJavaSignature(t *testing.T) {
+	infos := map[string]*pb.SymbolInformation{}
+	for _, text := range javaSymbols {
+		info := parseSymbolInformation(t, text)
+		infos[info.GetSymbol()] = info
+	}
+	lookup := func(symbol string) *pb.SymbolInformation { return infos[symbol] }
+
+	testCases := []struct {
+		info string
+		want string
+	}{
+		// Records and enums omit their implicit parents and modifiers
+		{
+			`symbol: "j/Point#" kind: CLASS properties: 0x8 display_name: "Point" access { public_access {} } signature { class_signature { parents { type_ref { symbol: "java/lang/Record#" } } parents { type_ref { symbol: "java/lang/Comparable#" type_arguments { type_ref { symbol: "j/Point#" } } } } } }`,
+			"public record Point implements Comparable<Point>",
+		},
+		{
+			`symbol: "j/Color#" kind: CLASS properties: 0x4008 display_name: "Color" access { public_access {} } signature { class_signature { parents { type_ref { symbol: "java/lang/Enum#" type_arguments { type_ref { symbol: "j/Color#" } } } } } }`,
+			"public enum Color",
+		},
+		{
+			`symbol: "j/Names#" kind: CLASS properties: 0x4 display_name: "Names" access { public_access {} } annotations { tpe { type_ref { symbol: "java/lang/Deprecated#" } } } signature { class_signature { parents { type_ref { symbol: "java/util/AbstractList#" type_arguments { type_ref { symbol: "java/lang/String#" } } } } parents { type_ref { symbol: "java/io/Serializable#" } } } }`,
+			"@Deprecated\npublic abstract class Names extends AbstractList<String> implements Serializable",
+		},
+		{
+			`symbol: "j/Shape#" kind: INTERFACE properties: 0x4 display_name: "Shape" signature { class_signature { type_parameters { hardlinks { symbol: "j/Shape#[T]" display_name: "T" signature { type_signature { upper_bound { intersection_type { types { type_ref { symbol: "java/lang/Number#" } } types { type_ref { symbol: "java/lang/Comparable#" type_arguments { type_ref { symbol: "j/Shape#[T]" } } } } } } } } } } parents { type_ref { symbol: "java/lang/Object#" } } parents { type_ref { symbol: "java/lang/Cloneable#" } } } }`,
+			"interface Shape<T extends Number & Comparable<T>> extends Cloneable",
+		},
+		// Type parameters and parameters are looked up
+		{
+			`symbol: "j/Util#of()." kind: METHOD properties: 0x1000 display_name: "of" access { public_access {} } signature { method_signature { type_parameters { symlinks: "j/Util#of().[T]" } parameter_lists { symlinks: "j/Util#of().(xs)" } return_type { type_ref { symbol: "java/util/List#" type_arguments { existential_type { tpe { type_ref { symbol: "local_wildcard" } } declarations { hardlinks { symbol: "local_wildcard" display_name: "?" signature { type_signature { upper_bound { type_ref { symbol: "j/Util#of().[T]" } } } } } } } } } } throws { type_ref { symbol: "java/io/IOException#" } } } }`,
+			"public static <T extends Number> List<? extends T> of(T... xs) throws IOException",
+		},
+		{
+			`symbol: "j/Box#<init>()." kind: CONSTRUCTOR display_name: "<init>" access { protected_access {} } signature { method_signature { parameter_lists { symlinks: "j/Box#<init>().(size)" } } }`,
+			"protected Box(int size)",
+		},
+		{
+			`symbol: "j/Shape#sort()." kind: METHOD properties: 0x8000 display_name: "sort" signature { method_signature { parameter_lists { hardlinks { symbol: "j/Shape#sort().(xs)" display_name: "xs" signature { value_signature { tpe { type_ref { symbol: "scala/Array#" type_arguments { type_ref { symbol: "scala/Long#" } } } } } } } hardlinks { symbol: "j/Shape#sort().(c)" display_name: "c" signature { value_signature { tpe { type_ref { symbol: "java/util/Comparator#" type_arguments { existential_type { tpe { type_ref { symbol: "local_wildcard" } } declarations { hardlinks { symbol: "local_wildcard" display_name: "?" signature { type_signature { lower_bound { type_ref { symbol: "java/lang/Long#" } } } } } } } } } } } } } } return_type { type_ref { symbol: "scala/Unit#" } } } }`,
+			"default void sort(long[] xs, Comparator<? super Long> c)",
+		},
+		{
+			`symbol: "j/Point#x." kind: FIELD properties: 0x8 display_name: "x" access { private_access {} } signature { value_signature { tpe { annotated_type { annotations { tpe { type_ref { symbol: "j/NonNull#" } } } tpe { type_ref { symbol: "java/lang/Integer#" } } } } } }`,
+			"private final @NonNull Integer x",
+		},
+		// Methods of interfaces are implicitly abstract, unlike those of
+		// abstract classes
+		{
+			`symbol: "j/Shape#area()." kind: METHOD properties: 0x4 display_name: "area" access { public_access {} } signature { method_signature { return_type { type_ref { symbol: "scala/Double#" } } } }`,
+			"public double area()",
+		},
+		{
+			`symbol: "j/Box#area()." kind: METHOD properties: 0x4 display_name: "area" access { public_access {} } signature { method_signature { return_type { type_ref { symbol: "scala/Double#" } } } }`,
+			"public abstract double area()",
+		},
+		// Symbols without a signature are not rendered
+		{
+			`symbol: "j/Util#x." kind: FIELD display_name: "x"`,
+			"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		info := parseSymbolInformation(t, testCase.info)
+		if got := JavaSignature(info, lookup); got != testCase.want {
+			t.Errorf("JavaSignature(%s):\n got %q\nwant %q", info.GetSymbol(), got, testCase.want)
+		}
+	}
+}
+
+func TestJavaSignatureWithoutLookup(t *testing.T) {
+	// Symlinks that cannot be looked up are rendered by name
+	info := parseSymbolInformation(t, `symbol: "j/Util#id()." kind: METHOD display_name: "id" signature { method_signature { type_parameters { symlinks: "j/Util#id().[T]" } parameter_lists { symlinks: "j/Util#id().(x)" } return_type { type_ref { symbol: "j/Util#id().[T]" } } } }`)
+
+	if got, want := JavaSignature(info, nil), "<T> T id(x)"; got != want {
+		t.Errorf("JavaSignature() = %q, want %q", got, want)
+	}
+}
+
+func TestHoverText(t *testing.T) {
+	field := parseSymbolInformation(t, `symbol: "j/Point#x." kind: FIELD display_name: "x" signature { value_signature { tpe { type_ref { symbol: "scala/Int#" } } } }`)
+	unsigned := parseSymbolInformation(t, `symbol: "j/Point#y." kind: FIELD display_name: "y"`)
+
+	testCases := []struct {
+		info    *pb.SymbolInformation
+		dialect Dialect
+		want    string
+	}{
+		{field, Java, "int x"},
+		{field, Scala2, "x"},
+		{field, Scala3, "x"},
+		{unsigned, Java, "y"},
+	}
+
+	for _, testCase := range testCases {
+		if got := HoverText(testCase.info, testCase.dialect, nil); got != testCase.want {
+			t.Errorf("HoverText(%s, %d) = %q, want %q", testCase.info.GetSymbol(), testCase.dialect, got, testCase.want)
+		}
+	}
+}
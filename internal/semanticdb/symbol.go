@@ -0,0 +1,247 @@
+package semanticdb
+
+import (
+	"strings"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+// IsLocal returns true if the symbol is local to a single document.
+func IsLocal(symbol string) bool {
+	return strings.HasPrefix(symbol, "local")
+}
+
+// Package returns the package prefix of a global symbol, including the
+// trailing slash, or the empty string for symbols in the root package.
+func Package(symbol string) string {
+	return symbol[:strings.LastIndex(symbol, "/")+1]
+}
+
+// Dialect determines the symbol conventions of a document.
+type Dialect int
+
+const (
+	// Scala2 documents are emitted by the Scala 2 compiler plugin. Documents
+	// of unknown origin are treated the same way.
+	Scala2 Dialect = iota
+	// Scala3 documents are emitted by the Scala 3 compiler.
+	Scala3
+	// Java documents are emitted by semanticdb-javac.
+	Java
+)
+
+// scala3Properties are the symbol properties only emitted by Scala 3.
+const scala3Properties = pb.SymbolInformation_GIVEN | pb.SymbolInformation_INLINE |
+	pb.SymbolInformation_OPEN | pb.SymbolInformation_TRANSPARENT |
+	pb.SymbolInformation_INFIX | pb.SymbolInformation_OPAQUE
+
+// DialectOf determines the dialect of a document. SemanticDB does not record
+// the compiler version, so Scala 3 documents are recognized by their synthetic
+// top-level objects and Scala 3 specific symbol properties.
+func DialectOf(document *pb.TextDocument) Dialect {
+	if document.GetLanguage() == pb.Language_JAVA {
+		return Java
+	}
+	if document.GetLanguage() != pb.Language_SCALA {
+		return Scala2
+	}
+
+	for _, symbol := range document.GetSymbols() {
+		if strings.Contains(symbol.GetSymbol(), "$package.") || symbol.GetProperties()&int32(scala3Properties) != 0 {
+			return Scala3
+		}
+	}
+
+	for _, occurrence := range document.GetOccurrences() {
+		if strings.Contains(occurrence.GetSymbol(), "$package.") {
+			return Scala3
+		}
+	}
+
+	return Scala2
+}
+
+// Candidates returns the global symbols a reference to the given symbol may
+// resolve to, in order of preference. The compiler does not always emit a
+// reference to the symbol that has a definition occurrence, so alternative
+// spellings are tried after the symbol itself.
+func Candidates(symbol string, dialect Dialect) []string {
+	switch dialect {
+	case Scala3:
+		return scala3Candidates(symbol)
+	case Java:
+		return javaCandidates(symbol)
+	}
+
+	keys := []string{symbol}
+	if owner, descriptor, ok := ParseSymbol(symbol); ok {
+		keys = append(keys, classCandidates(owner, descriptor)...)
+	}
+	keys = append(keys, strings.Replace(strings.Replace(symbol, "_=", "", -1), "`", "", -1)) // field assignment
+	return keys
+}
+
+// classCandidates returns the class declarations owning the members the Scala
+// compiler synthesizes for classes and their companion objects.
+func classCandidates(owner string, descriptor Descriptor) []string {
+	switch descriptor.Suffix {
+	case TermSuffix:
+		// Companion objects without a definition of their own, e.g. of case
+		// classes, enum cases and opaque types
+		return []string{owner + Descriptor{Name: descriptor.Name, Suffix: TypeSuffix}.String()}
+
+	case MethodSuffix:
+		ownerOwner, ownerDescriptor, ok := ParseSymbol(owner)
+		if !ok {
+			return nil
+		}
+
+		switch ownerDescriptor.Suffix {
+		case TermSuffix:
+			// Factories and extractors of companion objects, e.g. Foo(1) and
+			// case Foo(x)
+			switch descriptor.Name {
+			case "apply", "unapply", "unapplySeq":
+				return []string{ownerOwner + Descriptor{Name: ownerDescriptor.Name, Suffix: TypeSuffix}.String(), owner}
+			}
+
+		case TypeSuffix:
+			// Constructors and copy methods of case classes
+			if descriptor.Name == "<init>" || descriptor.Name == "copy" || strings.HasPrefix(descriptor.Name, "copy$default$") {
+				return []string{owner}
+			}
+		}
+
+	case ParameterSuffix:
+		// Named arguments of copy methods, e.g. p.copy(x = 3), name the
+		// field of the same name
+		ownerOwner, ownerDescriptor, ok := ParseSymbol(owner)
+		if !ok || ownerDescriptor.Suffix != MethodSuffix || ownerDescriptor.Name != "copy" || !strings.HasSuffix(ownerOwner, "#") {
+			return nil
+		}
+		return []string{ownerOwner + Descriptor{Name: descriptor.Name, Suffix: TermSuffix}.String()}
+	}
+
+	return nil
+}
+
+// scala3Candidates returns the alternative spellings of Scala 3 symbols.
+func scala3Candidates(symbol string) []string {
+	keys := []string{symbol}
+
+	owner, descriptor, ok := ParseSymbol(symbol)
+	if !ok {
+		return keys
+	}
+	keys = append(keys, classCandidates(owner, descriptor)...)
+
+	switch descriptor.Suffix {
+	case TermSuffix:
+		// Given instances become methods once they take using parameters
+		if strings.HasPrefix(descriptor.Name, "given_") {
+			keys = append(keys, owner+Descriptor{Name: descriptor.Name, Suffix: MethodSuffix, Disambiguator: "()"}.String())
+		}
+
+	case MethodSuffix:
+		// Setters of vars are defined by the field itself
+		if field := strings.TrimSuffix(descriptor.Name, "_="); field != descriptor.Name {
+			keys = append(keys, owner+Descriptor{Name: field, Suffix: TermSuffix}.String())
+			keys = append(keys, owner+Descriptor{Name: field, Suffix: MethodSuffix, Disambiguator: "()"}.String())
+		}
+
+		if strings.HasPrefix(descriptor.Name, "given_") {
+			keys = append(keys, owner+Descriptor{Name: descriptor.Name, Suffix: TermSuffix}.String())
+		}
+	}
+
+	return keys
+}
+
+// javaCandidates returns the alternative spellings of Java symbols. Members
+// the compiler generates have no definition of their own and resolve to the
+// declaration they are generated for. Implicit record accessors are resolved
+// by the Resolver, which knows the records.
+func javaCandidates(symbol string) []string {
+	keys := []string{symbol}
+
+	owner, descriptor, ok := ParseSymbol(symbol)
+	if !ok || descriptor.Suffix != MethodSuffix {
+		return keys
+	}
+
+	switch descriptor.Name {
+	case "<init>":
+		// Default and canonical record constructors resolve to the class
+		keys = append(keys, owner)
+	case "values", "valueOf":
+		// Implicit methods of enums resolve to the enum
+		keys = append(keys, owner)
+	}
+
+	return keys
+}
+
+// TopLevelKey returns the symbol without the synthetic object that wraps
+// top-level definitions, e.g. "pkg/Foo$package.bar()." and the Scala 2
+// package object member "pkg/package.bar()." both become "pkg/bar().". It
+// returns the empty string for symbols that are not top-level definitions.
+func TopLevelKey(symbol string) string {
+	pkg := Package(symbol)
+	rest := symbol[len(pkg):]
+
+	end := strings.IndexByte(rest, '.')
+	if end < 0 || end == len(rest)-1 {
+		return ""
+	}
+
+	if name := rest[:end]; name != "package" && !strings.HasSuffix(name, "$package") {
+		return ""
+	}
+
+	return pkg + rest[end+1:]
+}
+
+// ExportAliases returns the export forwarders of a Scala 3 document mapped to
+// the symbols they forward to. An export clause defines the forwarder at the
+// same range at which it references the exported member.
+func ExportAliases(document *pb.TextDocument) map[string]string {
+	aliases := map[string]string{}
+
+	references := map[rangeKey][]string{}
+	for _, occurrence := range document.GetOccurrences() {
+		if occurrence.GetRole() == pb.SymbolOccurrence_REFERENCE && occurrence.GetRange() != nil {
+			key := newRangeKey(occurrence.GetRange())
+			references[key] = append(references[key], occurrence.GetSymbol())
+		}
+	}
+
+	for _, occurrence := range document.GetOccurrences() {
+		forwarder := occurrence.GetSymbol()
+		if occurrence.GetRole() != pb.SymbolOccurrence_DEFINITION || occurrence.GetRange() == nil || IsLocal(forwarder) {
+			continue
+		}
+
+		forwarderOwner, forwarderDescriptor, ok := ParseSymbol(forwarder)
+		if !ok {
+			continue
+		}
+
+		for _, target := range references[newRangeKey(occurrence.GetRange())] {
+			owner, descriptor, ok := ParseSymbol(target)
+			if ok && owner != forwarderOwner && descriptor.Name == forwarderDescriptor.Name {
+				aliases[forwarder] = target
+				break
+			}
+		}
+	}
+
+	return aliases
+}
+
+type rangeKey struct {
+	startLine, startCharacter, endLine, endCharacter int32
+}
+
+func newRangeKey(r *pb.Range) rangeKey {
+	return rangeKey{r.GetStartLine(), r.GetStartCharacter(), r.GetEndLine(), r.GetEndCharacter()}
+}
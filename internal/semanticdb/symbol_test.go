@@ -0,0 +1,151 @@
+package semanticdb
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+func TestParseSymbol(t *testing.T) {
+	testCases := []struct {
+		symbol     string
+		owner      string
+		descriptor Descriptor
+		ok         bool
+	}{
+		{"scala/", "", Descriptor{Name: "scala", Suffix: PackageSuffix}, true},
+		{"scala/collection/", "scala/", Descriptor{Name: "collection", Suffix: PackageSuffix}, true},
+		{"a/Foo#", "a/", Descriptor{Name: "Foo", Suffix: TypeSuffix}, true},
+		{"a/Foo.", "a/", Descriptor{Name: "Foo", Suffix: TermSuffix}, true},
+		{"a/Foo#bar().", "a/Foo#", Descriptor{Name: "bar", Suffix: MethodSuffix, Disambiguator: "()"}, true},
+		{"a/Foo#bar(+1).", "a/Foo#", Descriptor{Name: "bar", Suffix: MethodSuffix, Disambiguator: "(+1)"}, true},
+		{"a/Foo#`<init>`().", "a/Foo#", Descriptor{Name: "<init>", Suffix: MethodSuffix, Disambiguator: "()"}, true},
+		{"a/Foo#bar().(x)", "a/Foo#bar().", Descriptor{Name: "x", Suffix: ParameterSuffix}, true},
+		{"a/Foo#[T]", "a/Foo#", Descriptor{Name: "T", Suffix: TypeParameterSuffix}, true},
+		{"a/`foo bar`.", "a/", Descriptor{Name: "foo bar", Suffix: TermSuffix}, true},
+		{"local0", "", Descriptor{}, false},
+		{"a", "", Descriptor{}, false},
+		{"a/Foo", "", Descriptor{}, false},
+		{"a/bar).", "", Descriptor{}, false},
+		{"a/().", "", Descriptor{}, false},
+		{"a/bar`.", "", Descriptor{}, false},
+	}
+
+	for _, testCase := range testCases {
+		owner, descriptor, ok := ParseSymbol(testCase.symbol)
+		if owner != testCase.owner || descriptor != testCase.descriptor || ok != testCase.ok {
+			t.Errorf("ParseSymbol(%q) = %q, %+v, %v, want %q, %+v, %v", testCase.symbol, owner, descriptor, ok, testCase.owner, testCase.descriptor, testCase.ok)
+		}
+
+		if ok && owner+descriptor.String() != testCase.symbol {
+			t.Errorf("ParseSymbol(%q) does not round trip: %q", testCase.symbol, owner+descriptor.String())
+		}
+	}
+}
+
+func TestTopLevelKey(t *testing.T) {
+	testCases := map[string]string{
+		"pkg/Foo$package.bar().":   "pkg/bar().",
+		"pkg/Foo$package.Bar#":     "pkg/Bar#",
+		"pkg/package.bar().":       "pkg/bar().",
+		"Foo$package.bar.":         "bar.",
+		"pkg/Foo$package.":         "",
+		"pkg/Foo.bar().":           "",
+		"pkg/Foo#bar().":           "",
+		"pkg/Foo$package#bar().":   "",
+		"pkg/sub/package.Baz#x().": "pkg/sub/Baz#x().",
+	}
+
+	for symbol, want := range testCases {
+		if got := TopLevelKey(symbol); got != want {
+			t.Errorf("TopLevelKey(%q) = %q, want %q", symbol, got, want)
+		}
+	}
+}
+
+func TestExportAliases(t *testing.T) {
+	occurrence := func(line int32, symbol string, role pb.SymbolOccurrence_Role) *pb.SymbolOccurrence {
+		return &pb.SymbolOccurrence{Range: &pb.Range{StartLine: line, StartCharacter: 18, EndLine: line, EndCharacter: 23}, Symbol: symbol, Role: role}
+	}
+
+	document := &pb.TextDocument{
+		Occurrences: []*pb.SymbolOccurrence{
+			// export printer.print
+			occurrence(1, "s3/Copier#print().", pb.SymbolOccurrence_DEFINITION),
+			occurrence(1, "s3/Copier#printer.", pb.SymbolOccurrence_REFERENCE),
+			occurrence(1, "s3/Printer#print().", pb.SymbolOccurrence_REFERENCE),
+			// A definition sharing its range with a reference to a member of
+			// the same owner is not a forwarder
+			occurrence(2, "s3/Copier#copy().", pb.SymbolOccurrence_DEFINITION),
+			occurrence(2, "s3/Copier#copy(+1).", pb.SymbolOccurrence_REFERENCE),
+			// Neither is a definition sharing its range with another name
+			occurrence(3, "s3/Copier#size().", pb.SymbolOccurrence_DEFINITION),
+			occurrence(3, "s3/Printer#length().", pb.SymbolOccurrence_REFERENCE),
+			occurrence(4, "local0", pb.SymbolOccurrence_DEFINITION),
+			occurrence(4, "s3/Printer#local0.", pb.SymbolOccurrence_REFERENCE),
+		},
+	}
+
+	want := map[string]string{"s3/Copier#print().": "s3/Printer#print()."}
+	if got := ExportAliases(document); !reflect.DeepEqual(got, want) {
+		t.Errorf("ExportAliases() = %v, want %v", got, want)
+	}
+}
+
+func TestClassCandidates(t *testing.T) {
+	testCases := []struct {
+		symbol string
+		want   []string
+	}{
+		// Companion objects of case classes
+		{"a/Point.", []string{"a/Point#"}},
+		{"a/Point.apply().", []string{"a/Point#", "a/Point."}},
+		{"a/Point.unapply().", []string{"a/Point#", "a/Point."}},
+		{"a/Point.unapplySeq().", []string{"a/Point#", "a/Point."}},
+		{"a/Point.tupled().", nil},
+		// Constructors and copy methods
+		{"a/Point#`<init>`().", []string{"a/Point#"}},
+		{"a/Point#copy().", []string{"a/Point#"}},
+		{"a/Point#copy$default$1().", []string{"a/Point#"}},
+		{"a/Point#copy().(x)", []string{"a/Point#x."}},
+		{"a/Point#copy(+1).(y)", []string{"a/Point#y."}},
+		// Parameters of other methods and of copy methods of objects
+		{"a/Point#move().(x)", nil},
+		{"a/Point.copy().(x)", nil},
+		{"a/Point#`<init>`().(x)", nil},
+		{"a/Point#", nil},
+	}
+
+	for _, testCase := range testCases {
+		owner, descriptor, ok := ParseSymbol(testCase.symbol)
+		if !ok {
+			t.Fatalf("ParseSymbol(%q) failed", testCase.symbol)
+		}
+		if got := classCandidates(owner, descriptor); !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("classCandidates(%q) = %v, want %v", testCase.symbol, got, testCase.want)
+		}
+	}
+}
+
+func TestScala3Candidates(t *testing.T) {
+	testCases := map[string][]string{
+		"s3/given_Printer.":        {"s3/given_Printer.", "s3/given_Printer#", "s3/given_Printer()."},
+		"s3/given_Printer().":      {"s3/given_Printer().", "s3/given_Printer."},
+		"s3/Counter#count_=().":    {"s3/Counter#count_=().", "s3/Counter#count.", "s3/Counter#count()."},
+		"s3/Color.Mix.":            {"s3/Color.Mix.", "s3/Color.Mix#"},
+		"s3/Counter#`<init>`().":   {"s3/Counter#`<init>`().", "s3/Counter#"},
+		"s3/Units.Meters.apply().": {"s3/Units.Meters.apply().", "s3/Units.Meters#", "s3/Units.Meters."},
+		"s3/Printer#print().":      {"s3/Printer#print()."},
+		"local0":                   {"local0"},
+	}
+
+	for symbol, want := range testCases {
+		if got := scala3Candidates(symbol); !reflect.DeepEqual(got, want) {
+			t.Errorf("scala3Candidates(%q) = %v, want %v", symbol, got, want)
+		}
+		if got := Candidates(symbol, Scala3); !reflect.DeepEqual(got, want) {
+			t.Errorf("Candidates(%q, Scala3) = %v, want %v", symbol, got, want)
+		}
+	}
+}
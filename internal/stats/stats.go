@@ -0,0 +1,222 @@
+// Package stats summarizes the contents of SemanticDB documents without
+// indexing them.
+package stats
+
+import (
+	"sort"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+// Report contains statistics of a set of SemanticDB documents.
+type Report struct {
+	NumDocuments              int            `json:"numDocuments"`
+	DocumentsByLanguage       map[string]int `json:"documentsByLanguage"`
+	DocumentsBySchema         map[string]int `json:"documentsBySchema"`
+	OccurrencesByRole         map[string]int `json:"occurrencesByRole"`
+	LocalDefinitions          int            `json:"localDefinitions"`
+	GlobalDefinitions         int            `json:"globalDefinitions"`
+	UnresolvedReferences      int            `json:"unresolvedReferences"`
+	UnresolvedSymbols         int            `json:"unresolvedSymbols"`
+	SymbolsWithoutInformation int            `json:"symbolsWithoutInformation"`
+	DiagnosticsBySeverity     map[string]int `json:"diagnosticsBySeverity"`
+	TopFiles                  []FileCount    `json:"topFiles"`
+	TopUnresolved             []SymbolCount  `json:"topUnresolved"`
+}
+
+// FileCount is the number of occurrences in a single document.
+type FileCount struct {
+	URI         string `json:"uri"`
+	Occurrences int    `json:"occurrences"`
+}
+
+// SymbolCount is the number of occurrences of a single symbol.
+type SymbolCount struct {
+	Symbol      string `json:"symbol"`
+	Occurrences int    `json:"occurrences"`
+}
+
+// Collector accumulates statistics over documents.
+type Collector struct {
+	report *Report
+	files  []FileCount
+
+	// Global symbols with a definition occurrence
+	defined map[string]bool
+	// Occurrence counts of referenced global symbols
+	referenced map[reference]int
+	resolver   *semanticdb.Resolver
+	// Locals per document that are referenced but never defined
+	unresolvedLocals map[string]int
+	// Defined symbols without SymbolInformation, locals prefixed by their
+	// document
+	withoutInformation map[string]bool
+}
+
+// NewCollector creates a new Collector.
+func NewCollector() *Collector {
+	return &Collector{
+		report: &Report{
+			DocumentsByLanguage:   map[string]int{},
+			DocumentsBySchema:     map[string]int{},
+			OccurrencesByRole:     map[string]int{},
+			DiagnosticsBySeverity: map[string]int{},
+		},
+		defined:            map[string]bool{},
+		referenced:         map[reference]int{},
+		resolver:           semanticdb.NewResolver(),
+		unresolvedLocals:   map[string]int{},
+		withoutInformation: map[string]bool{},
+	}
+}
+
+// Add accumulates the statistics of a single document.
+func (c *Collector) Add(document *pb.TextDocument) {
+	r := c.report
+	r.NumDocuments++
+	r.DocumentsByLanguage[document.GetLanguage().String()]++
+	r.DocumentsBySchema[document.GetSchema().String()]++
+
+	symbols := map[string]bool{}
+	for _, symbol := range document.GetSymbols() {
+		symbols[symbol.GetSymbol()] = true
+	}
+
+	c.resolver.AddDocument(document)
+	dialect := semanticdb.DialectOf(document)
+
+	localDefs := map[string]bool{}
+	for _, occurrence := range document.GetOccurrences() {
+		if occurrence.GetRole() != pb.SymbolOccurrence_DEFINITION {
+			continue
+		}
+
+		symbol := occurrence.GetSymbol()
+		if semanticdb.IsLocal(symbol) {
+			if !localDefs[symbol] {
+				r.LocalDefinitions++
+			}
+			localDefs[symbol] = true
+		} else {
+			if !c.defined[symbol] {
+				r.GlobalDefinitions++
+			}
+			c.defined[symbol] = true
+		}
+
+		if !symbols[symbol] {
+			key := symbol
+			if semanticdb.IsLocal(symbol) {
+				key = document.GetUri() + " " + symbol
+			}
+			if !c.withoutInformation[key] {
+				c.withoutInformation[key] = true
+				r.SymbolsWithoutInformation++
+			}
+		}
+	}
+
+	for _, occurrence := range document.GetOccurrences() {
+		r.OccurrencesByRole[occurrence.GetRole().String()]++
+		if occurrence.GetRole() != pb.SymbolOccurrence_REFERENCE {
+			continue
+		}
+
+		symbol := occurrence.GetSymbol()
+		if !semanticdb.IsLocal(symbol) {
+			c.referenced[reference{symbol, dialect}]++
+		} else if !localDefs[symbol] {
+			c.unresolvedLocals[document.GetUri()+" "+symbol]++
+		}
+	}
+
+	for _, diagnostic := range document.GetDiagnostics() {
+		r.DiagnosticsBySeverity[diagnostic.GetSeverity().String()]++
+	}
+
+	c.files = append(c.files, FileCount{
+		URI:         document.GetUri(),
+		Occurrences: len(document.GetOccurrences()),
+	})
+}
+
+// Report returns the statistics of all documents added so far. The top files
+// and unresolved symbols are limited to the given number of entries, or not
+// limited if it is negative.
+func (c *Collector) Report(top int) *Report {
+	r := *c.report
+	r.UnresolvedReferences = 0
+	r.UnresolvedSymbols = 0
+
+	var unresolved []SymbolCount
+	counts := map[string]int{}
+	for ref, n := range c.referenced {
+		if !c.resolves(ref) {
+			counts[ref.symbol] += n
+		}
+	}
+	for symbol, n := range counts {
+		r.UnresolvedReferences += n
+		r.UnresolvedSymbols++
+		unresolved = append(unresolved, SymbolCount{Symbol: symbol, Occurrences: n})
+	}
+	for _, n := range c.unresolvedLocals {
+		r.UnresolvedReferences += n
+		r.UnresolvedSymbols++
+	}
+
+	sort.Slice(unresolved, func(i, j int) bool {
+		if unresolved[i].Occurrences != unresolved[j].Occurrences {
+			return unresolved[i].Occurrences > unresolved[j].Occurrences
+		}
+		return unresolved[i].Symbol < unresolved[j].Symbol
+	})
+
+	files := append([]FileCount(nil), c.files...)
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].Occurrences != files[j].Occurrences {
+			return files[i].Occurrences > files[j].Occurrences
+		}
+		return files[i].URI < files[j].URI
+	})
+
+	r.TopUnresolved = truncateSymbols(unresolved, top)
+	r.TopFiles = truncateFiles(files, top)
+	return &r
+}
+
+// reference is a global symbol referenced from a document of a dialect.
+type reference struct {
+	symbol  string
+	dialect semanticdb.Dialect
+}
+
+// resolves returns true if the reference would be linked to a definition by
+// the indexer.
+func (c *Collector) resolves(ref reference) bool {
+	_, ok := c.resolver.Resolve(ref.symbol, ref.dialect, func(symbol string) bool {
+		return c.defined[symbol]
+	})
+	return ok
+}
+
+func truncateSymbols(counts []SymbolCount, n int) []SymbolCount {
+	if n >= 0 && len(counts) > n {
+		counts = counts[:n]
+	}
+	if counts == nil {
+		counts = []SymbolCount{}
+	}
+	return counts
+}
+
+func truncateFiles(counts []FileCount, n int) []FileCount {
+	if n >= 0 && len(counts) > n {
+		counts = counts[:n]
+	}
+	if counts == nil {
+		counts = []FileCount{}
+	}
+	return counts
+}
@@ -0,0 +1,150 @@
+package stats
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+)
+
+func occurrence(symbol string, role pb.SymbolOccurrence_Role) *pb.SymbolOccurrence {
+	return &pb.SymbolOccurrence{Range: &pb.Range{}, Symbol: symbol, Role: role}
+}
+
+func testDocuments() []*pb.TextDocument {
+	return []*pb.TextDocument{
+		{
+			Uri:      "a/A.scala",
+			Schema:   pb.Schema_SEMANTICDB4,
+			Language: pb.Language_SCALA,
+			Symbols: []*pb.SymbolInformation{
+				{Symbol: "a/A#"},
+			},
+			Occurrences: []*pb.SymbolOccurrence{
+				occurrence("a/A#", pb.SymbolOccurrence_DEFINITION),
+				// Defined twice without information, counted once
+				occurrence("a/A#foo().", pb.SymbolOccurrence_DEFINITION),
+				occurrence("a/A#foo().", pb.SymbolOccurrence_DEFINITION),
+				occurrence("local0", pb.SymbolOccurrence_DEFINITION),
+				occurrence("local0", pb.SymbolOccurrence_REFERENCE),
+				occurrence("local1", pb.SymbolOccurrence_REFERENCE),
+				occurrence("b/B#", pb.SymbolOccurrence_REFERENCE),
+				occurrence("c/C#", pb.SymbolOccurrence_REFERENCE),
+				occurrence("c/C#", pb.SymbolOccurrence_REFERENCE),
+			},
+			Diagnostics: []*pb.Diagnostic{
+				{Severity: pb.Diagnostic_WARNING},
+			},
+		},
+		{
+			Uri:      "b/B.scala",
+			Schema:   pb.Schema_SEMANTICDB4,
+			Language: pb.Language_SCALA,
+			Occurrences: []*pb.SymbolOccurrence{
+				occurrence("b/B#", pb.SymbolOccurrence_DEFINITION),
+				// Defined again in another document without information
+				occurrence("a/A#foo().", pb.SymbolOccurrence_DEFINITION),
+				// Locals of different documents are different symbols
+				occurrence("local0", pb.SymbolOccurrence_DEFINITION),
+			},
+		},
+	}
+}
+
+func TestCollector(t *testing.T) {
+	c := NewCollector()
+	for _, document := range testDocuments() {
+		c.Add(document)
+	}
+
+	want := &Report{
+		NumDocuments:        2,
+		DocumentsByLanguage: map[string]int{"SCALA": 2},
+		DocumentsBySchema:   map[string]int{"SEMANTICDB4": 2},
+		OccurrencesByRole: map[string]int{
+			"DEFINITION": 7,
+			"REFERENCE":  5,
+		},
+		LocalDefinitions:          2,
+		GlobalDefinitions:         3,
+		UnresolvedReferences:      3,
+		UnresolvedSymbols:         2,
+		SymbolsWithoutInformation: 4,
+		DiagnosticsBySeverity:     map[string]int{"WARNING": 1},
+		TopFiles: []FileCount{
+			{URI: "a/A.scala", Occurrences: 9},
+		},
+		TopUnresolved: []SymbolCount{
+			{Symbol: "c/C#", Occurrences: 2},
+		},
+	}
+	if got := c.Report(1); !reflect.DeepEqual(got, want) {
+		t.Errorf("Report(1) = %+v, want %+v", got, want)
+	}
+}
+
+func TestCollectorUnlimited(t *testing.T) {
+	c := NewCollector()
+	for _, document := range testDocuments() {
+		c.Add(document)
+	}
+
+	// A negative number lists all files and unresolved global symbols
+	r := c.Report(-1)
+	if len(r.TopFiles) != 2 || len(r.TopUnresolved) != 1 {
+		t.Errorf("Report(-1) lists %d files and %d symbols, want 2 and 1", len(r.TopFiles), len(r.TopUnresolved))
+	}
+}
+
+func TestCollectorEmpty(t *testing.T) {
+	r := NewCollector().Report(10)
+	if r.TopFiles == nil || r.TopUnresolved == nil {
+		t.Errorf("expected empty top lists, got %+v and %+v", r.TopFiles, r.TopUnresolved)
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	c := NewCollector()
+	for _, document := range testDocuments() {
+		c.Add(document)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteText(&buf, c.Report(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `Documents:  2
+  By language:
+    SCALA  2
+  By schema:
+    SEMANTICDB4  2
+
+Occurrences:
+  By role:
+    DEFINITION  7
+    REFERENCE   5
+
+Symbols:
+  Global definitions         3
+  Local definitions          2
+  Without SymbolInformation  4
+  Unresolved symbols         2
+  Unresolved references      3
+
+Diagnostics:
+  By severity:
+    WARNING  1
+
+Top files by occurrences:
+  a/A.scala  9
+  b/B.scala  3
+
+Top unresolved symbols:
+  c/C#  2
+`
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected text:\n%s\nwant:\n%s", got, want)
+	}
+}
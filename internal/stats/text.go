@@ -0,0 +1,64 @@
+package stats
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+)
+
+// WriteText writes a human-readable rendering of the report.
+func WriteText(w io.Writer, r *Report) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Documents:\t%d\n", r.NumDocuments)
+	writeCounts(tw, "By language", r.DocumentsByLanguage)
+	writeCounts(tw, "By schema", r.DocumentsBySchema)
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "Occurrences:")
+	writeCounts(tw, "By role", r.OccurrencesByRole)
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "Symbols:")
+	fmt.Fprintf(tw, "  Global definitions\t%d\n", r.GlobalDefinitions)
+	fmt.Fprintf(tw, "  Local definitions\t%d\n", r.LocalDefinitions)
+	fmt.Fprintf(tw, "  Without SymbolInformation\t%d\n", r.SymbolsWithoutInformation)
+	fmt.Fprintf(tw, "  Unresolved symbols\t%d\n", r.UnresolvedSymbols)
+	fmt.Fprintf(tw, "  Unresolved references\t%d\n", r.UnresolvedReferences)
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "Diagnostics:")
+	writeCounts(tw, "By severity", r.DiagnosticsBySeverity)
+
+	if len(r.TopFiles) > 0 {
+		fmt.Fprintln(tw)
+		fmt.Fprintln(tw, "Top files by occurrences:")
+		for _, f := range r.TopFiles {
+			fmt.Fprintf(tw, "  %s\t%d\n", f.URI, f.Occurrences)
+		}
+	}
+
+	if len(r.TopUnresolved) > 0 {
+		fmt.Fprintln(tw)
+		fmt.Fprintln(tw, "Top unresolved symbols:")
+		for _, s := range r.TopUnresolved {
+			fmt.Fprintf(tw, "  %s\t%d\n", s.Symbol, s.Occurrences)
+		}
+	}
+
+	return tw.Flush()
+}
+
+func writeCounts(w io.Writer, title string, counts map[string]int) {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(w, "  %s:\n", title)
+	for _, key := range keys {
+		fmt.Fprintf(w, "    %s\t%d\n", key, counts[key])
+	}
+}
@@ -0,0 +1,279 @@
+// Package validate checks the structural soundness of LSIF dumps.
+package validate
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/lsif"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+// Names of the checks performed by Validate.
+const (
+	CheckIDs        = "ids"
+	CheckEdges      = "edges"
+	CheckContains   = "contains"
+	CheckDefinition = "definition"
+	CheckOverlap    = "overlap"
+)
+
+// Report contains the problems found in a dump. Errors make the dump invalid,
+// warnings do not.
+type Report struct {
+	NumVertices int            `json:"numVertices"`
+	NumEdges    int            `json:"numEdges"`
+	NumErrors   map[string]int `json:"numErrors"`
+	NumWarnings map[string]int `json:"numWarnings"`
+	Errors      []Problem      `json:"errors"`
+	Warnings    []Problem      `json:"warnings"`
+}
+
+// Problem describes a single failed check.
+type Problem struct {
+	Check   string `json:"check"`
+	ID      uint64 `json:"id,omitempty"`
+	Message string `json:"message"`
+}
+
+// Valid returns true if no errors were found.
+func (r *Report) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// ErrorCount returns the number of errors found, including those not listed
+// due to the limit.
+func (r *Report) ErrorCount() int {
+	n := 0
+	for _, count := range r.NumErrors {
+		n += count
+	}
+	return n
+}
+
+// validator accumulates problems of a single dump.
+type validator struct {
+	report   *Report
+	limit    int
+	elements []*lsif.Element
+	vertices map[uint64]*lsif.Element
+}
+
+// Validate checks the given elements of a dump. At most limit problems are
+// listed per check; all problems are counted regardless of the limit. A limit
+// of zero or less lists every problem.
+func Validate(elements []*lsif.Element, limit int) *Report {
+	v := &validator{
+		report: &Report{
+			NumErrors:   map[string]int{},
+			NumWarnings: map[string]int{},
+			Errors:      []Problem{},
+			Warnings:    []Problem{},
+		},
+		limit:    limit,
+		elements: elements,
+		vertices: map[uint64]*lsif.Element{},
+	}
+
+	v.checkIDs()
+	v.checkEdges()
+	v.checkContains()
+	v.checkDefinitions()
+	v.checkOverlaps()
+	return v.report
+}
+
+func (v *validator) errorf(check string, id uint64, format string, args ...interface{}) {
+	v.report.NumErrors[check]++
+	if v.limit <= 0 || v.report.NumErrors[check] <= v.limit {
+		v.report.Errors = append(v.report.Errors, Problem{Check: check, ID: id, Message: fmt.Sprintf(format, args...)})
+	}
+}
+
+func (v *validator) warnf(check string, id uint64, format string, args ...interface{}) {
+	v.report.NumWarnings[check]++
+	if v.limit <= 0 || v.report.NumWarnings[check] <= v.limit {
+		v.report.Warnings = append(v.report.Warnings, Problem{Check: check, ID: id, Message: fmt.Sprintf(format, args...)})
+	}
+}
+
+// checkIDs ensures that element identifiers are unique and increasing. It also
+// indexes all vertices for the remaining checks.
+func (v *validator) checkIDs() {
+	seen := map[uint64]bool{}
+	var last uint64
+
+	for _, e := range v.elements {
+		switch {
+		case e.IsVertex():
+			v.report.NumVertices++
+			v.vertices[e.ID] = e
+		case e.IsEdge():
+			v.report.NumEdges++
+		default:
+			v.errorf(CheckIDs, e.ID, "element has unknown type %q", e.Type)
+		}
+
+		if seen[e.ID] {
+			v.errorf(CheckIDs, e.ID, "duplicate id %d", e.ID)
+			continue
+		}
+		if e.ID <= last {
+			v.errorf(CheckIDs, e.ID, "id %d does not increase after id %d", e.ID, last)
+		}
+
+		seen[e.ID] = true
+		last = e.ID
+	}
+}
+
+// checkEdges ensures that every edge references existing vertices.
+func (v *validator) checkEdges() {
+	for _, e := range v.elements {
+		if !e.IsEdge() {
+			continue
+		}
+
+		if e.InV == 0 && len(e.InVs) == 0 {
+			v.errorf(CheckEdges, e.ID, "%s edge has no in vertex", e.Label)
+		}
+
+		v.checkReference(e, "outV", e.OutV)
+		if e.InV != 0 {
+			v.checkReference(e, "inV", e.InV)
+		}
+		for _, id := range e.InVs {
+			v.checkReference(e, "inVs", id)
+		}
+		if e.Document != 0 {
+			v.checkReference(e, "document", e.Document)
+		}
+	}
+}
+
+func (v *validator) checkReference(e *lsif.Element, field string, id uint64) {
+	if _, ok := v.vertices[id]; !ok {
+		v.errorf(CheckEdges, e.ID, "%s edge references unknown vertex %d in %s", e.Label, id, field)
+	}
+}
+
+// checkContains ensures that every range is contained in exactly one document.
+func (v *validator) checkContains() {
+	documents := map[uint64][]uint64{}
+	for _, e := range v.elements {
+		if !e.IsEdge() || e.Label != "contains" {
+			continue
+		}
+		if outV, ok := v.vertices[e.OutV]; !ok || outV.Label != "document" {
+			continue
+		}
+
+		for _, id := range e.InVs {
+			documents[id] = append(documents[id], e.OutV)
+		}
+	}
+
+	for _, e := range v.elements {
+		if !e.IsVertex() || e.Label != "range" {
+			continue
+		}
+
+		switch n := len(documents[e.ID]); {
+		case n == 0:
+			v.errorf(CheckContains, e.ID, "range %d is not contained in any document", e.ID)
+		case n > 1:
+			v.errorf(CheckContains, e.ID, "range %d is contained in %d documents %v", e.ID, n, documents[e.ID])
+		}
+	}
+}
+
+// checkDefinitions ensures that each result set or range has at most one
+// definition result.
+func (v *validator) checkDefinitions() {
+	counts := map[uint64]int{}
+	for _, e := range v.elements {
+		if e.IsEdge() && e.Label == "textDocument/definition" {
+			counts[e.OutV]++
+		}
+	}
+
+	for _, e := range v.elements {
+		if n := counts[e.ID]; e.IsVertex() && n > 1 {
+			v.errorf(CheckDefinition, e.ID, "%s %d has %d definition results", e.Label, e.ID, n)
+		}
+	}
+}
+
+// checkOverlaps ensures that the ranges of a document are either disjoint or
+// nested. Identical ranges are reported as warnings. The ranges of a document
+// may be spread over several contains edges.
+func (v *validator) checkOverlaps() {
+	var documents []uint64
+	ranges := map[uint64][]*lsif.Element{}
+	for _, e := range v.elements {
+		if !e.IsEdge() || e.Label != "contains" {
+			continue
+		}
+		if outV, ok := v.vertices[e.OutV]; !ok || outV.Label != "document" {
+			continue
+		}
+
+		if _, ok := ranges[e.OutV]; !ok {
+			documents = append(documents, e.OutV)
+		}
+		for _, id := range e.InVs {
+			if r, ok := v.vertices[id]; ok && r.Label == "range" && r.Start != nil && r.End != nil {
+				ranges[e.OutV] = append(ranges[e.OutV], r)
+			}
+		}
+		if ranges[e.OutV] == nil {
+			ranges[e.OutV] = []*lsif.Element{}
+		}
+	}
+
+	for _, id := range documents {
+		v.checkDocumentOverlaps(v.vertices[id], ranges[id])
+	}
+}
+
+func (v *validator) checkDocumentOverlaps(document *lsif.Element, ranges []*lsif.Element) {
+	sort.Slice(ranges, func(i, j int) bool {
+		if c := comparePos(*ranges[i].Start, *ranges[j].Start); c != 0 {
+			return c < 0
+		}
+		return comparePos(*ranges[i].End, *ranges[j].End) > 0
+	})
+
+	// Stack of ranges enclosing the current one
+	var stack []*lsif.Element
+	for i, r := range ranges {
+		if i > 0 {
+			prev := ranges[i-1]
+			if comparePos(*prev.Start, *r.Start) == 0 && comparePos(*prev.End, *r.End) == 0 {
+				v.warnf(CheckOverlap, r.ID, "range %d duplicates range %d in %s", r.ID, prev.ID, document.URI)
+				continue
+			}
+		}
+
+		for len(stack) > 0 && comparePos(*stack[len(stack)-1].End, *r.Start) <= 0 {
+			stack = stack[:len(stack)-1]
+		}
+
+		if len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if comparePos(*r.End, *top.End) > 0 {
+				v.errorf(CheckOverlap, r.ID, "range %d partially overlaps range %d in %s", r.ID, top.ID, document.URI)
+				continue
+			}
+		}
+
+		stack = append(stack, r)
+	}
+}
+
+func comparePos(a, b protocol.Pos) int {
+	if a.Line != b.Line {
+		return a.Line - b.Line
+	}
+	return a.Character - b.Character
+}
@@ -0,0 +1,155 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/lsif"
+)
+
+// validDump is a document with a nested range and a definition result.
+const validDump = `
+{"id":1,"type":"vertex","label":"document","uri":"file:///a.scala"}
+{"id":2,"type":"vertex","label":"range","start":{"line":0,"character":0},"end":{"line":0,"character":10}}
+{"id":3,"type":"vertex","label":"range","start":{"line":0,"character":2},"end":{"line":0,"character":4}}
+{"id":4,"type":"edge","label":"contains","outV":1,"inVs":[2,3]}
+{"id":5,"type":"vertex","label":"definitionResult"}
+{"id":6,"type":"edge","label":"textDocument/definition","outV":3,"inV":5}
+{"id":7,"type":"edge","label":"item","outV":5,"inVs":[3],"document":1}
+`
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		dump         string
+		wantErrors   map[string]int
+		wantWarnings map[string]int
+	}{
+		{
+			name: "valid",
+			dump: validDump,
+		},
+		{
+			name:       "unknown element type",
+			dump:       validDump + `{"id":8,"type":"hyperedge","label":"next"}`,
+			wantErrors: map[string]int{CheckIDs: 1},
+		},
+		{
+			name:       "duplicate id",
+			dump:       validDump + `{"id":7,"type":"vertex","label":"resultSet"}`,
+			wantErrors: map[string]int{CheckIDs: 1},
+		},
+		{
+			name: "decreasing id",
+			dump: `
+{"id":2,"type":"vertex","label":"resultSet"}
+{"id":1,"type":"vertex","label":"resultSet"}
+`,
+			wantErrors: map[string]int{CheckIDs: 1},
+		},
+		{
+			name:       "unknown vertex",
+			dump:       validDump + `{"id":8,"type":"edge","label":"next","outV":3,"inV":42}`,
+			wantErrors: map[string]int{CheckEdges: 1},
+		},
+		{
+			name:       "edge without in vertex",
+			dump:       validDump + `{"id":8,"type":"edge","label":"next","outV":3}`,
+			wantErrors: map[string]int{CheckEdges: 1},
+		},
+		{
+			name:       "unknown item document",
+			dump:       validDump + `{"id":8,"type":"edge","label":"item","outV":5,"inVs":[2],"document":42}`,
+			wantErrors: map[string]int{CheckEdges: 1},
+		},
+		{
+			name:       "range outside documents",
+			dump:       validDump + `{"id":8,"type":"vertex","label":"range","start":{"line":1,"character":0},"end":{"line":1,"character":1}}`,
+			wantErrors: map[string]int{CheckContains: 1},
+		},
+		{
+			name: "range in two documents",
+			dump: validDump + `
+{"id":8,"type":"vertex","label":"document","uri":"file:///b.scala"}
+{"id":9,"type":"edge","label":"contains","outV":8,"inVs":[3]}
+`,
+			wantErrors: map[string]int{CheckContains: 1},
+		},
+		{
+			name: "two definition results",
+			dump: validDump + `
+{"id":8,"type":"vertex","label":"definitionResult"}
+{"id":9,"type":"edge","label":"textDocument/definition","outV":3,"inV":8}
+`,
+			wantErrors: map[string]int{CheckDefinition: 1},
+		},
+		{
+			name: "partially overlapping ranges",
+			dump: validDump + `
+{"id":8,"type":"vertex","label":"range","start":{"line":0,"character":8},"end":{"line":0,"character":12}}
+{"id":9,"type":"edge","label":"contains","outV":1,"inVs":[8]}
+`,
+			wantErrors: map[string]int{CheckOverlap: 1},
+		},
+		{
+			name: "identical ranges",
+			dump: validDump + `
+{"id":8,"type":"vertex","label":"range","start":{"line":0,"character":2},"end":{"line":0,"character":4}}
+{"id":9,"type":"edge","label":"contains","outV":1,"inVs":[8]}
+`,
+			wantWarnings: map[string]int{CheckOverlap: 1},
+		},
+		{
+			name: "adjacent ranges",
+			dump: validDump + `
+{"id":8,"type":"vertex","label":"range","start":{"line":0,"character":10},"end":{"line":0,"character":12}}
+{"id":9,"type":"edge","label":"contains","outV":1,"inVs":[8]}
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			elements, err := lsif.Read(strings.NewReader(strings.TrimSpace(testCase.dump) + "\n"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			report := Validate(elements, 0)
+			if !counts(report.NumErrors, testCase.wantErrors) {
+				t.Errorf("errors: %v, want %v", report.Errors, testCase.wantErrors)
+			}
+			if !counts(report.NumWarnings, testCase.wantWarnings) {
+				t.Errorf("warnings: %v, want %v", report.Warnings, testCase.wantWarnings)
+			}
+			if report.Valid() != (len(testCase.wantErrors) == 0) {
+				t.Errorf("Valid() = %v", report.Valid())
+			}
+		})
+	}
+}
+
+func TestValidateLimit(t *testing.T) {
+	elements, err := lsif.Read(strings.NewReader(`{"id":1,"type":"vertex","label":"range"}
+{"id":2,"type":"vertex","label":"range"}
+{"id":3,"type":"vertex","label":"range"}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := Validate(elements, 2)
+	if len(report.Errors) != 2 || report.ErrorCount() != 3 {
+		t.Errorf("listed %d of %d errors, want 2 of 3", len(report.Errors), report.ErrorCount())
+	}
+}
+
+// counts compares the problem counts of a report, treating nil and empty
+// maps as equal.
+func counts(got, want map[string]int) bool {
+	if len(got) == 0 && len(want) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(got, want)
+}
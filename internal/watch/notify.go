@@ -0,0 +1,114 @@
+package watch
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+)
+
+// notifier watches every directory of the watched trees with fsnotify, which
+// does not watch subdirectories by itself.
+type notifier struct {
+	watcher *fsnotify.Watcher
+	events  chan<- string
+	errors  chan<- error
+	done    <-chan struct{}
+}
+
+func newBackend(dirs []string, events chan<- string, errors chan<- error, done <-chan struct{}) (backend, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	n := &notifier{
+		watcher: watcher,
+		events:  events,
+		errors:  errors,
+		done:    done,
+	}
+
+	for _, dir := range dirs {
+		if err := n.addTree(dir, false); err != nil {
+			_ = watcher.Close()
+			return nil, err
+		}
+	}
+
+	go n.read()
+	return n, nil
+}
+
+func (n *notifier) close() error {
+	return n.watcher.Close()
+}
+
+// addTree watches dir and all of its subdirectories. If report is true, the
+// SemanticDB files already present are reported as changed; this covers files
+// written into a new directory before its watch was added.
+func (n *notifier) addTree(dir string, report bool) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if !info.IsDir() {
+			if report && semanticdb.IsFile(path) {
+				n.send(path)
+			}
+			return nil
+		}
+
+		return n.watcher.Add(path)
+	})
+}
+
+func (n *notifier) send(path string) {
+	select {
+	case n.events <- path:
+	case <-n.done:
+	}
+}
+
+// read forwards events until the watcher is closed.
+func (n *notifier) read() {
+	for {
+		select {
+		case event, ok := <-n.watcher.Events:
+			if !ok {
+				return
+			}
+			n.handle(event)
+
+		case err, ok := <-n.watcher.Errors:
+			if !ok {
+				return
+			}
+			sendError(n.errors, err)
+
+		case <-n.done:
+			return
+		}
+	}
+}
+
+func (n *notifier) handle(event fsnotify.Event) {
+	if event.Op&fsnotify.Create != 0 {
+		if info, err := os.Lstat(event.Name); err == nil && info.IsDir() {
+			if err := n.addTree(event.Name, true); err != nil {
+				sendError(n.errors, err)
+			}
+			return
+		}
+	}
+
+	// Removed and renamed directories drop their watches by themselves
+	if semanticdb.IsFile(event.Name) {
+		n.send(event.Name)
+	}
+}
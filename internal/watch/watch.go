@@ -0,0 +1,102 @@
+// Package watch reports changes to SemanticDB files below a set of
+// directories, observed with fsnotify.
+package watch
+
+import (
+	"sort"
+	"time"
+)
+
+// quietPeriod is how long the watcher waits for further changes before
+// reporting a batch. Compilers write many files in quick succession.
+const quietPeriod = 250 * time.Millisecond
+
+// Watcher reports batches of changed SemanticDB files.
+type Watcher struct {
+	changes chan []string
+	errors  chan error
+	events  chan string
+	done    chan struct{}
+	backend backend
+}
+
+// backend is a source of changed paths.
+type backend interface {
+	close() error
+}
+
+// New starts watching the given directories recursively.
+func New(dirs []string) (*Watcher, error) {
+	w := &Watcher{
+		changes: make(chan []string),
+		errors:  make(chan error, 1),
+		events:  make(chan string, 64),
+		done:    make(chan struct{}),
+	}
+
+	b, err := newBackend(dirs, w.events, w.errors, w.done)
+	if err != nil {
+		return nil, err
+	}
+	w.backend = b
+
+	go w.batch()
+	return w, nil
+}
+
+// Changes returns the channel of changed, created or removed SemanticDB files.
+func (w *Watcher) Changes() <-chan []string {
+	return w.changes
+}
+
+// Errors returns the channel of errors that occurred while watching.
+func (w *Watcher) Errors() <-chan error {
+	return w.errors
+}
+
+// Close stops watching.
+func (w *Watcher) Close() error {
+	close(w.done)
+	return w.backend.close()
+}
+
+// batch collects changed paths until no further change arrives within the
+// quiet period and then reports them at once.
+func (w *Watcher) batch() {
+	pending := map[string]bool{}
+	timer := time.NewTimer(quietPeriod)
+	timer.Stop()
+
+	for {
+		select {
+		case path := <-w.events:
+			pending[path] = true
+			timer.Reset(quietPeriod)
+
+		case <-timer.C:
+			paths := make([]string, 0, len(pending))
+			for path := range pending {
+				paths = append(paths, path)
+			}
+			sort.Strings(paths)
+			pending = map[string]bool{}
+
+			select {
+			case w.changes <- paths:
+			case <-w.done:
+				return
+			}
+
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// sendError reports an error unless one is already pending.
+func sendError(errors chan<- error, err error) {
+	select {
+	case errors <- err:
+	default:
+	}
+}
@@ -0,0 +1,75 @@
+package watch
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := New([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	// expect waits for the next batch and checks that it contains the paths
+	expect := func(want ...string) {
+		t.Helper()
+
+		select {
+		case changes := <-w.Changes():
+			got := map[string]bool{}
+			for _, path := range changes {
+				got[path] = true
+			}
+			for _, path := range want {
+				if !got[path] {
+					t.Fatalf("changes %v do not contain %s", changes, path)
+				}
+			}
+		case err := <-w.Errors():
+			t.Fatal(err)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("no changes reported, want %v", want)
+		}
+	}
+	write := func(path string) {
+		if err := ioutil.WriteFile(path, []byte("contents"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	a := filepath.Join(dir, "a.semanticdb")
+	write(a)
+	// Other files are not reported
+	write(filepath.Join(dir, "a.txt"))
+	expect(a)
+
+	// Files in new directories are reported, including those written before
+	// the directory is watched
+	sub := filepath.Join(dir, "sub", "META-INF")
+	if err := os.MkdirAll(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	b := filepath.Join(sub, "b.semanticdb")
+	write(b)
+	expect(b)
+
+	if err := os.Remove(a); err != nil {
+		t.Fatal(err)
+	}
+	c := filepath.Join(sub, "c.semanticdb")
+	if err := os.Rename(b, c); err != nil {
+		t.Fatal(err)
+	}
+	expect(a, b, c)
+}
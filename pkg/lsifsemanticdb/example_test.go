@@ -0,0 +1,31 @@
+package lsifsemanticdb_test
+
+import (
+	"log"
+	"os"
+
+	"github.com/sourcegraph/lsif-semanticdb/pkg/lsifsemanticdb"
+)
+
+func ExampleIndex() {
+	out, err := os.Create("dump.lsif")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+
+	stats, err := lsifsemanticdb.Index(
+		[]string{"target/scala-2.13/meta"},
+		lsifsemanticdb.WithOutput(out),
+		lsifsemanticdb.WithProjectRoot("."),
+		lsifsemanticdb.WithFilter(lsifsemanticdb.Filter{ExcludeDocuments: []string{"**/test/**"}}),
+		lsifsemanticdb.WithProgress(func(phase string, done, total int) {
+			log.Printf("%s: %d/%d", phase, done, total)
+		}),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("%d file(s), %d def(s)", stats.NumFiles, stats.NumDefs)
+}
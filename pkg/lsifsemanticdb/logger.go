@@ -0,0 +1,39 @@
+package lsifsemanticdb
+
+import "github.com/sourcegraph/lsif-semanticdb/internal/log"
+
+// Field is a key-value pair describing a logged message, e.g. the URI of a
+// document or the symbol a warning refers to.
+type Field = log.Field
+
+// Logger receives leveled messages about the progress of indexing together
+// with the fields describing them.
+type Logger interface {
+	Debug(msg string, fields ...Field)
+	Info(msg string, fields ...Field)
+	Warn(msg string, fields ...Field)
+	Error(msg string, fields ...Field)
+}
+
+// fieldLogger adapts a Logger to the structured logger of the indexer by
+// adding the fields of derived loggers to each message.
+type fieldLogger struct {
+	logger Logger
+	fields []Field
+}
+
+func (l *fieldLogger) Debug(msg string, fields ...Field) { l.logger.Debug(msg, l.with(fields)...) }
+func (l *fieldLogger) Info(msg string, fields ...Field)  { l.logger.Info(msg, l.with(fields)...) }
+func (l *fieldLogger) Warn(msg string, fields ...Field)  { l.logger.Warn(msg, l.with(fields)...) }
+func (l *fieldLogger) Error(msg string, fields ...Field) { l.logger.Error(msg, l.with(fields)...) }
+
+func (l *fieldLogger) With(fields ...Field) log.Logger {
+	return &fieldLogger{logger: l.logger, fields: l.with(fields)}
+}
+
+func (l *fieldLogger) with(fields []Field) []Field {
+	if len(l.fields) == 0 {
+		return fields
+	}
+	return append(append([]Field(nil), l.fields...), fields...)
+}
@@ -0,0 +1,222 @@
+// Package lsifsemanticdb converts SemanticDB files into LSIF dumps. It is the
+// library behind the lsif-semanticdb command.
+//
+// The packages below pkg follow semantic versioning: exported identifiers are
+// not removed or changed incompatibly within a major version, and new options
+// may be added at any time. The contents of the dump are not covered by this
+// guarantee beyond conforming to the LSIF specification; new vertices and
+// edges may be emitted as the indexer improves. Packages below internal have
+// no compatibility guarantees.
+package lsifsemanticdb
+
+import (
+	"errors"
+	"io"
+
+	"github.com/sourcegraph/lsif-semanticdb/internal/index"
+	"github.com/sourcegraph/lsif-semanticdb/internal/log"
+	"github.com/sourcegraph/sourcegraph/enterprise/lib/codeintel/lsif/protocol"
+)
+
+// Version is the version of the indexer recorded in the metadata of dumps.
+const Version = "0.4.1"
+
+// Languages of LSIF projects.
+const (
+	LanguageScala = index.LanguageScala
+	LanguageJava  = index.LanguageJava
+)
+
+// Encodings of the character offsets of ranges.
+const (
+	PositionEncodingUTF16     = index.PositionEncodingUTF16
+	PositionEncodingUTF8      = index.PositionEncodingUTF8
+	PositionEncodingCodePoint = index.PositionEncodingCodePoint
+)
+
+// Phases of indexing reported to progress callbacks.
+const (
+	PhaseLoading     = index.PhaseLoading
+	PhaseDocuments   = index.PhaseDocuments
+	PhaseDefinitions = index.PhaseDefinitions
+	PhaseUses        = index.PhaseUses
+	PhaseLinking     = index.PhaseLinking
+)
+
+// ProgressEvent describes the progress of a phase of indexing.
+type ProgressEvent = index.ProgressEvent
+
+// Progress receives progress events while indexing. The events of a phase
+// start with Done set to zero and end with Finished set.
+type Progress = index.Progress
+
+// Filter selects the documents and symbols that are indexed. Document
+// patterns are globs matched against document URIs in which ** matches any
+// number of directories; symbol patterns are prefixes of global symbols.
+type Filter = index.Filter
+
+// MonikerScheme is the scheme of the monikers identifying SemanticDB symbols.
+const MonikerScheme = index.MonikerScheme
+
+// Stats describes a generated dump.
+type Stats struct {
+	NumFiles    uint
+	NumDefs     uint
+	NumElements uint64
+
+	// Occurrences dropped because of a missing, invalid or out-of-bounds range
+	NumRejectedOccurrences uint
+}
+
+// Option configures an Indexer.
+type Option func(*options)
+
+type options struct {
+	out   io.Writer
+	args  []string
+	index index.Options
+}
+
+// WithOutput sets the writer the dump is written to. It is required.
+func WithOutput(w io.Writer) Option {
+	return func(o *options) { o.out = w }
+}
+
+// WithProjectRoot sets the directory that document URIs in SemanticDB files
+// are relative to. It defaults to the working directory.
+func WithProjectRoot(dir string) Option {
+	return func(o *options) { o.index.SourceRoot = dir }
+}
+
+// WithLanguage sets the language of the LSIF project. Documents keep the
+// language recorded in SemanticDB. It defaults to LanguageScala.
+func WithLanguage(language string) Option {
+	return func(o *options) { o.index.Language = language }
+}
+
+// WithPositionEncoding sets the encoding of the character offsets of ranges:
+// PositionEncodingUTF16, the encoding of SemanticDB, PositionEncodingUTF8 or
+// PositionEncodingCodePoint. Documents are converted using their text, or the
+// source file if SemanticDB does not contain it; documents without either are
+// skipped.
+func WithPositionEncoding(encoding string) Option {
+	return func(o *options) { o.index.PositionEncoding = encoding }
+}
+
+// WithContents embeds the text of each document into the dump, base64 encoded
+// as required by LSIF. The text is taken from SemanticDB, or read from the
+// source file if SemanticDB does not contain it. Contents are not embedded by
+// default.
+func WithContents(contents bool) Option {
+	return func(o *options) { o.index.Contents = contents }
+}
+
+// WithFilter restricts the documents and symbols that are indexed. Invalid
+// patterns are reported by Index.
+func WithFilter(filter Filter) Option {
+	return func(o *options) { o.index.Filter = filter }
+}
+
+// WithMonikers attaches export monikers naming the given package to global
+// definitions. References to symbols defined by other packages get import
+// monikers; packages maps those symbols to the name of their package and may
+// be nil.
+func WithMonikers(packageName, packageVersion string, packages map[string]string) Option {
+	return func(o *options) {
+		o.index.Monikers = &index.Monikers{
+			PackageName:    packageName,
+			PackageVersion: packageVersion,
+			Packages:       packages,
+		}
+	}
+}
+
+// WithLogger sets the logger receiving messages about the progress of
+// indexing. Nothing is logged by default or if the logger is nil.
+func WithLogger(logger Logger) Option {
+	return func(o *options) {
+		if logger == nil {
+			o.index.Logger = log.Nop
+			return
+		}
+		o.index.Logger = &fieldLogger{logger: logger}
+	}
+}
+
+// WithProgress sets a function called after each document of a phase is
+// processed with the number of documents processed so far and in total. It is
+// not called for the loading phase, whose total is unknown, nor at the start
+// and end of phases; use WithProgressReporter to receive all events.
+func WithProgress(fn func(phase string, done, total int)) Option {
+	return WithProgressReporter(index.ProgressFunc(func(event ProgressEvent) {
+		if event.Phase != PhaseLoading && event.Done > 0 && !event.Finished {
+			fn(event.Phase, event.Done, event.Total)
+		}
+	}))
+}
+
+// WithProgressReporter sets the receiver of progress events.
+func WithProgressReporter(progress Progress) Option {
+	return func(o *options) { o.index.Progress = progress }
+}
+
+// WithArgs sets the arguments recorded in the tool information of the dump.
+func WithArgs(args []string) Option {
+	return func(o *options) { o.args = args }
+}
+
+// Indexer converts SemanticDB files into an LSIF dump.
+type Indexer struct {
+	indexer index.Indexer
+}
+
+// ErrNoOutput is returned by NewIndexer if no output was configured.
+var ErrNoOutput = errors.New("lsifsemanticdb: no output configured")
+
+// NewIndexer creates an Indexer for the SemanticDB files in the given
+// directories.
+func NewIndexer(dirs []string, opts ...Option) (*Indexer, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.out == nil {
+		return nil, ErrNoOutput
+	}
+	toolInfo := protocol.ToolInfo{
+		Name:    "lsif-semanticdb",
+		Version: Version,
+		Args:    o.args,
+	}
+
+	return &Indexer{indexer: index.NewIndexer(dirs, toolInfo, o.out, o.index)}, nil
+}
+
+// Index writes the dump. It is the caller's responsibility to close the output
+// if applicable.
+func (x *Indexer) Index() (*Stats, error) {
+	s, err := x.indexer.Index()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Stats{
+		NumFiles:    s.NumFiles,
+		NumDefs:     s.NumDefs,
+		NumElements: s.NumElements,
+
+		NumRejectedOccurrences: s.NumRejectedOccurrences,
+	}, nil
+}
+
+// Index converts the SemanticDB files in the given directories into an LSIF
+// dump in a single call.
+func Index(dirs []string, opts ...Option) (*Stats, error) {
+	x, err := NewIndexer(dirs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return x.Index()
+}
@@ -0,0 +1,138 @@
+package lsifsemanticdb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testDocuments = `documents {
+  uri: "a/A.scala"
+  text: "object A { A }\n"
+  language: SCALA
+  occurrences { range { start_line: 0 start_character: 7 end_line: 0 end_character: 8 } symbol: "a/A." role: DEFINITION }
+  occurrences { range { start_line: 0 start_character: 11 end_line: 0 end_character: 12 } symbol: "a/A." role: REFERENCE }
+}
+`
+
+// testDir returns a directory containing a SemanticDB file of testDocuments.
+func testDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "A.scala.semanticdb.textproto"), []byte(testDocuments), 0644); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+// testLogger records the messages it receives as text.
+type testLogger struct {
+	messages []string
+}
+
+func (l *testLogger) Debug(msg string, fields ...Field) { l.log("DEBUG", msg, fields) }
+func (l *testLogger) Info(msg string, fields ...Field)  { l.log("INFO", msg, fields) }
+func (l *testLogger) Warn(msg string, fields ...Field)  { l.log("WARN", msg, fields) }
+func (l *testLogger) Error(msg string, fields ...Field) { l.log("ERROR", msg, fields) }
+
+func (l *testLogger) log(level, msg string, fields []Field) {
+	message := level + " " + msg
+	for _, field := range fields {
+		message += fmt.Sprintf(" %s=%v", field.Key, field.Value)
+	}
+	l.messages = append(l.messages, message)
+}
+
+func (l *testLogger) logged(message string) bool {
+	for _, m := range l.messages {
+		if m == message {
+			return true
+		}
+	}
+	return false
+}
+
+func TestIndex(t *testing.T) {
+	dir, cleanup := testDir(t)
+	defer cleanup()
+
+	var out bytes.Buffer
+	logger := &testLogger{}
+	var phases []string
+
+	stats, err := Index([]string{dir},
+		WithOutput(&out),
+		WithProjectRoot(dir),
+		WithContents(true),
+		WithLogger(logger),
+		WithProgress(func(phase string, done, total int) {
+			if done < 1 || done > total {
+				t.Errorf("progress of %s: %d of %d documents", phase, done, total)
+			}
+			if len(phases) == 0 || phases[len(phases)-1] != phase {
+				phases = append(phases, phase)
+			}
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats.NumFiles != 1 || stats.NumDefs != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+	for _, message := range []string{"INFO Emitting documents", "DEBUG Emitting definitions uri=a/A.scala"} {
+		if !logger.logged(message) {
+			t.Errorf("%q was not logged in %q", message, logger.messages)
+		}
+	}
+	if want := []string{PhaseDocuments, PhaseDefinitions, PhaseUses, PhaseLinking}; !reflect.DeepEqual(phases, want) {
+		t.Errorf("phases %v, want %v", phases, want)
+	}
+
+	var contents []string
+	decoder := json.NewDecoder(&out)
+	for decoder.More() {
+		var element struct {
+			Label    string `json:"label"`
+			Contents string `json:"contents"`
+		}
+		if err := decoder.Decode(&element); err != nil {
+			t.Fatal(err)
+		}
+		if element.Label == "document" {
+			contents = append(contents, element.Contents)
+		}
+	}
+
+	want := []string{base64.StdEncoding.EncodeToString([]byte("object A { A }\n"))}
+	if !reflect.DeepEqual(contents, want) {
+		t.Errorf("document contents %v, want %v", contents, want)
+	}
+}
+
+func TestNewIndexerWithoutOutput(t *testing.T) {
+	if _, err := NewIndexer([]string{"."}); err != ErrNoOutput {
+		t.Errorf("NewIndexer() error %v, want %v", err, ErrNoOutput)
+	}
+}
+
+func TestIndexWithNilLogger(t *testing.T) {
+	dir, cleanup := testDir(t)
+	defer cleanup()
+
+	if _, err := Index([]string{dir}, WithOutput(ioutil.Discard), WithProjectRoot(dir), WithLogger(nil)); err != nil {
+		t.Fatal(err)
+	}
+}
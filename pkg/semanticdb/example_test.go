@@ -0,0 +1,27 @@
+package semanticdb_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/sourcegraph/lsif-semanticdb/pkg/semanticdb"
+)
+
+func ExampleLoad() {
+	documents, err := semanticdb.Load([]string{"target/scala-2.13/meta"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, document := range documents {
+		for _, info := range document.GetSymbols() {
+			if info.GetKind() != semanticdb.SymbolInformation_METHOD {
+				continue
+			}
+
+			if s, ok := info.GetSignature().GetSealedValue().(*semanticdb.Signature_MethodSignature); ok {
+				fmt.Println(document.GetUri(), info.GetSymbol(), len(s.MethodSignature.GetParameterLists()))
+			}
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package semanticdb
+
+import pb "github.com/sourcegraph/lsif-semanticdb/internal/proto"
+
+// Messages of the SemanticDB schema.
+type (
+	TextDocuments         = pb.TextDocuments
+	TextDocument          = pb.TextDocument
+	Range                 = pb.Range
+	Location              = pb.Location
+	Scope                 = pb.Scope
+	Type                  = pb.Type
+	TypeRef               = pb.TypeRef
+	SingleType            = pb.SingleType
+	ThisType              = pb.ThisType
+	SuperType             = pb.SuperType
+	ConstantType          = pb.ConstantType
+	IntersectionType      = pb.IntersectionType
+	UnionType             = pb.UnionType
+	WithType              = pb.WithType
+	StructuralType        = pb.StructuralType
+	AnnotatedType         = pb.AnnotatedType
+	ExistentialType       = pb.ExistentialType
+	UniversalType         = pb.UniversalType
+	ByNameType            = pb.ByNameType
+	RepeatedType          = pb.RepeatedType
+	MatchType             = pb.MatchType
+	LambdaType            = pb.LambdaType
+	Constant              = pb.Constant
+	UnitConstant          = pb.UnitConstant
+	BooleanConstant       = pb.BooleanConstant
+	ByteConstant          = pb.ByteConstant
+	ShortConstant         = pb.ShortConstant
+	CharConstant          = pb.CharConstant
+	IntConstant           = pb.IntConstant
+	LongConstant          = pb.LongConstant
+	FloatConstant         = pb.FloatConstant
+	DoubleConstant        = pb.DoubleConstant
+	StringConstant        = pb.StringConstant
+	NullConstant          = pb.NullConstant
+	Signature             = pb.Signature
+	ClassSignature        = pb.ClassSignature
+	MethodSignature       = pb.MethodSignature
+	TypeSignature         = pb.TypeSignature
+	ValueSignature        = pb.ValueSignature
+	SymbolInformation     = pb.SymbolInformation
+	Documentation         = pb.Documentation
+	Annotation            = pb.Annotation
+	Access                = pb.Access
+	PrivateAccess         = pb.PrivateAccess
+	PrivateThisAccess     = pb.PrivateThisAccess
+	PrivateWithinAccess   = pb.PrivateWithinAccess
+	ProtectedAccess       = pb.ProtectedAccess
+	ProtectedThisAccess   = pb.ProtectedThisAccess
+	ProtectedWithinAccess = pb.ProtectedWithinAccess
+	PublicAccess          = pb.PublicAccess
+	SymbolOccurrence      = pb.SymbolOccurrence
+	Diagnostic            = pb.Diagnostic
+	Synthetic             = pb.Synthetic
+	Tree                  = pb.Tree
+	ApplyTree             = pb.ApplyTree
+	FunctionTree          = pb.FunctionTree
+	IdTree                = pb.IdTree
+	LiteralTree           = pb.LiteralTree
+	MacroExpansionTree    = pb.MacroExpansionTree
+	OriginalTree          = pb.OriginalTree
+	SelectTree            = pb.SelectTree
+	TypeApplyTree         = pb.TypeApplyTree
+	MatchType_CaseType    = pb.MatchType_CaseType
+)
+
+// Wrappers of the alternatives of the oneof fields of the schema, e.g. the
+// values of Signature.SealedValue.
+type (
+	Type_TypeRef                 = pb.Type_TypeRef
+	Type_SingleType              = pb.Type_SingleType
+	Type_ThisType                = pb.Type_ThisType
+	Type_SuperType               = pb.Type_SuperType
+	Type_ConstantType            = pb.Type_ConstantType
+	Type_IntersectionType        = pb.Type_IntersectionType
+	Type_UnionType               = pb.Type_UnionType
+	Type_WithType                = pb.Type_WithType
+	Type_StructuralType          = pb.Type_StructuralType
+	Type_AnnotatedType           = pb.Type_AnnotatedType
+	Type_ExistentialType         = pb.Type_ExistentialType
+	Type_UniversalType           = pb.Type_UniversalType
+	Type_ByNameType              = pb.Type_ByNameType
+	Type_RepeatedType            = pb.Type_RepeatedType
+	Type_MatchType               = pb.Type_MatchType
+	Type_LambdaType              = pb.Type_LambdaType
+	Constant_UnitConstant        = pb.Constant_UnitConstant
+	Constant_BooleanConstant     = pb.Constant_BooleanConstant
+	Constant_ByteConstant        = pb.Constant_ByteConstant
+	Constant_ShortConstant       = pb.Constant_ShortConstant
+	Constant_CharConstant        = pb.Constant_CharConstant
+	Constant_IntConstant         = pb.Constant_IntConstant
+	Constant_LongConstant        = pb.Constant_LongConstant
+	Constant_FloatConstant       = pb.Constant_FloatConstant
+	Constant_DoubleConstant      = pb.Constant_DoubleConstant
+	Constant_StringConstant      = pb.Constant_StringConstant
+	Constant_NullConstant        = pb.Constant_NullConstant
+	Signature_ClassSignature     = pb.Signature_ClassSignature
+	Signature_MethodSignature    = pb.Signature_MethodSignature
+	Signature_TypeSignature      = pb.Signature_TypeSignature
+	Signature_ValueSignature     = pb.Signature_ValueSignature
+	Access_PrivateAccess         = pb.Access_PrivateAccess
+	Access_PrivateThisAccess     = pb.Access_PrivateThisAccess
+	Access_PrivateWithinAccess   = pb.Access_PrivateWithinAccess
+	Access_ProtectedAccess       = pb.Access_ProtectedAccess
+	Access_ProtectedThisAccess   = pb.Access_ProtectedThisAccess
+	Access_ProtectedWithinAccess = pb.Access_ProtectedWithinAccess
+	Access_PublicAccess          = pb.Access_PublicAccess
+	Tree_ApplyTree               = pb.Tree_ApplyTree
+	Tree_FunctionTree            = pb.Tree_FunctionTree
+	Tree_IdTree                  = pb.Tree_IdTree
+	Tree_LiteralTree             = pb.Tree_LiteralTree
+	Tree_MacroExpansionTree      = pb.Tree_MacroExpansionTree
+	Tree_OriginalTree            = pb.Tree_OriginalTree
+	Tree_SelectTree              = pb.Tree_SelectTree
+	Tree_TypeApplyTree           = pb.Tree_TypeApplyTree
+)
+
+// Enumerations of the SemanticDB schema.
+type (
+	Schema                     = pb.Schema
+	Language                   = pb.Language
+	SymbolInformation_Kind     = pb.SymbolInformation_Kind
+	SymbolInformation_Property = pb.SymbolInformation_Property
+	Documentation_Format       = pb.Documentation_Format
+	SymbolOccurrence_Role      = pb.SymbolOccurrence_Role
+	Diagnostic_Severity        = pb.Diagnostic_Severity
+)
+
+// Values of Schema.
+const (
+	Schema_LEGACY      = pb.Schema_LEGACY
+	Schema_SEMANTICDB3 = pb.Schema_SEMANTICDB3
+	Schema_SEMANTICDB4 = pb.Schema_SEMANTICDB4
+)
+
+// Values of Language.
+const (
+	Language_UNKNOWN_LANGUAGE = pb.Language_UNKNOWN_LANGUAGE
+	Language_SCALA            = pb.Language_SCALA
+	Language_JAVA             = pb.Language_JAVA
+)
+
+// Values of SymbolInformation_Kind.
+const (
+	SymbolInformation_UNKNOWN_KIND   = pb.SymbolInformation_UNKNOWN_KIND
+	SymbolInformation_LOCAL          = pb.SymbolInformation_LOCAL
+	SymbolInformation_FIELD          = pb.SymbolInformation_FIELD
+	SymbolInformation_METHOD         = pb.SymbolInformation_METHOD
+	SymbolInformation_CONSTRUCTOR    = pb.SymbolInformation_CONSTRUCTOR
+	SymbolInformation_MACRO          = pb.SymbolInformation_MACRO
+	SymbolInformation_TYPE           = pb.SymbolInformation_TYPE
+	SymbolInformation_PARAMETER      = pb.SymbolInformation_PARAMETER
+	SymbolInformation_SELF_PARAMETER = pb.SymbolInformation_SELF_PARAMETER
+	SymbolInformation_TYPE_PARAMETER = pb.SymbolInformation_TYPE_PARAMETER
+	SymbolInformation_OBJECT         = pb.SymbolInformation_OBJECT
+	SymbolInformation_PACKAGE        = pb.SymbolInformation_PACKAGE
+	SymbolInformation_PACKAGE_OBJECT = pb.SymbolInformation_PACKAGE_OBJECT
+	SymbolInformation_CLASS          = pb.SymbolInformation_CLASS
+	SymbolInformation_TRAIT          = pb.SymbolInformation_TRAIT
+	SymbolInformation_INTERFACE      = pb.SymbolInformation_INTERFACE
+)
+
+// Values of SymbolInformation_Property.
+const (
+	SymbolInformation_UNKNOWN_PROPERTY = pb.SymbolInformation_UNKNOWN_PROPERTY
+	SymbolInformation_ABSTRACT         = pb.SymbolInformation_ABSTRACT
+	SymbolInformation_FINAL            = pb.SymbolInformation_FINAL
+	SymbolInformation_SEALED           = pb.SymbolInformation_SEALED
+	SymbolInformation_IMPLICIT         = pb.SymbolInformation_IMPLICIT
+	SymbolInformation_LAZY             = pb.SymbolInformation_LAZY
+	SymbolInformation_CASE             = pb.SymbolInformation_CASE
+	SymbolInformation_COVARIANT        = pb.SymbolInformation_COVARIANT
+	SymbolInformation_CONTRAVARIANT    = pb.SymbolInformation_CONTRAVARIANT
+	SymbolInformation_VAL              = pb.SymbolInformation_VAL
+	SymbolInformation_VAR              = pb.SymbolInformation_VAR
+	SymbolInformation_STATIC           = pb.SymbolInformation_STATIC
+	SymbolInformation_PRIMARY          = pb.SymbolInformation_PRIMARY
+	SymbolInformation_ENUM             = pb.SymbolInformation_ENUM
+	SymbolInformation_DEFAULT          = pb.SymbolInformation_DEFAULT
+	SymbolInformation_GIVEN            = pb.SymbolInformation_GIVEN
+	SymbolInformation_INLINE           = pb.SymbolInformation_INLINE
+	SymbolInformation_OPEN             = pb.SymbolInformation_OPEN
+	SymbolInformation_TRANSPARENT      = pb.SymbolInformation_TRANSPARENT
+	SymbolInformation_INFIX            = pb.SymbolInformation_INFIX
+	SymbolInformation_OPAQUE           = pb.SymbolInformation_OPAQUE
+)
+
+// Values of Documentation_Format.
+const (
+	Documentation_HTML     = pb.Documentation_HTML
+	Documentation_MARKDOWN = pb.Documentation_MARKDOWN
+	Documentation_JAVADOC  = pb.Documentation_JAVADOC
+	Documentation_SCALADOC = pb.Documentation_SCALADOC
+	Documentation_KDOC     = pb.Documentation_KDOC
+)
+
+// Values of SymbolOccurrence_Role.
+const (
+	SymbolOccurrence_UNKNOWN_ROLE = pb.SymbolOccurrence_UNKNOWN_ROLE
+	SymbolOccurrence_REFERENCE    = pb.SymbolOccurrence_REFERENCE
+	SymbolOccurrence_DEFINITION   = pb.SymbolOccurrence_DEFINITION
+)
+
+// Values of Diagnostic_Severity.
+const (
+	Diagnostic_UNKNOWN_SEVERITY = pb.Diagnostic_UNKNOWN_SEVERITY
+	Diagnostic_ERROR            = pb.Diagnostic_ERROR
+	Diagnostic_WARNING          = pb.Diagnostic_WARNING
+	Diagnostic_INFORMATION      = pb.Diagnostic_INFORMATION
+	Diagnostic_HINT             = pb.Diagnostic_HINT
+)
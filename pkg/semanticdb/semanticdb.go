@@ -0,0 +1,50 @@
+// Package semanticdb loads SemanticDB files into typed documents.
+//
+// The document types, enumerations and oneof wrappers are aliases of the
+// generated protocol buffer types of the SemanticDB schema, so all fields,
+// getters and enumeration values of the schema are available. This package
+// follows the compatibility guarantees described in the documentation of
+// package lsifsemanticdb.
+package semanticdb
+
+import "github.com/sourcegraph/lsif-semanticdb/internal/semanticdb"
+
+// Extensions of SemanticDB files. Besides the binary files written by the
+// compiler plugins, files in the protobuf text and JSON encodings are read.
+const (
+	Extension          = semanticdb.Extension
+	TextprotoExtension = semanticdb.TextprotoExtension
+	JSONExtension      = semanticdb.JSONExtension
+)
+
+// ReadFile decodes the SemanticDB file at the given path in the format
+// indicated by its extension.
+func ReadFile(path string) (*TextDocuments, error) {
+	return semanticdb.ReadFile(path)
+}
+
+// Walk decodes every SemanticDB file found in the given directories and calls
+// fn with its path and documents. Walking stops at the first error.
+func Walk(dirs []string, fn func(path string, textDocuments *TextDocuments) error) error {
+	return semanticdb.Walk(dirs, fn)
+}
+
+// Load returns the documents of all SemanticDB files found in the given
+// directories.
+func Load(dirs []string) ([]*TextDocument, error) {
+	var documents []*TextDocument
+	err := Walk(dirs, func(path string, textDocuments *TextDocuments) error {
+		documents = append(documents, textDocuments.GetDocuments()...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return documents, nil
+}
+
+// IsLocal returns true if the symbol is local to a single document.
+func IsLocal(symbol string) bool {
+	return semanticdb.IsLocal(symbol)
+}
@@ -0,0 +1,55 @@
+package semanticdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lsif-semanticdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	contents := `documents {
+  uri: "a/A.java"
+  language: JAVA
+  symbols { symbol: "a/A#" kind: CLASS properties: 0x8 signature { class_signature {} } }
+  occurrences { symbol: "a/A#" role: DEFINITION }
+}
+`
+	if err := ioutil.WriteFile(filepath.Join(dir, "A.java"+TextprotoExtension), []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	documents, err := Load([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(documents) != 1 {
+		t.Fatalf("got %d documents, want 1", len(documents))
+	}
+
+	document := documents[0]
+	if document.GetLanguage() != Language_JAVA {
+		t.Errorf("language %s, want %s", document.GetLanguage(), Language_JAVA)
+	}
+	if role := document.GetOccurrences()[0].GetRole(); role != SymbolOccurrence_DEFINITION {
+		t.Errorf("role %s, want %s", role, SymbolOccurrence_DEFINITION)
+	}
+
+	info := document.GetSymbols()[0]
+	if info.GetKind() != SymbolInformation_CLASS || info.GetProperties()&int32(SymbolInformation_FINAL) == 0 {
+		t.Errorf("unexpected symbol information %v", info)
+	}
+	if _, ok := info.GetSignature().GetSealedValue().(*Signature_ClassSignature); !ok {
+		t.Errorf("unexpected signature %v", info.GetSignature())
+	}
+
+	if _, err := Load([]string{filepath.Join(dir, "missing")}); err == nil {
+		t.Errorf("expected an error for a missing directory")
+	}
+}